@@ -1,6 +1,8 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"log"
 	"net"
 	"os"
@@ -10,6 +12,7 @@ import (
 
 	pb "github.com/entl/blockterm/gen/proto"
 	"github.com/entl/blockterm/internal/history"
+	"github.com/entl/blockterm/internal/recording"
 	"github.com/entl/blockterm/internal/server"
 	"github.com/entl/blockterm/internal/session"
 	"github.com/entl/blockterm/internal/storage"
@@ -27,17 +30,29 @@ var (
 )
 
 func main() {
+	limits := sessionLimitFlags()
+	flag.Parse()
+
 	// Set up logging to stdout
 	log.SetOutput(os.Stdout)
 
+	if limits.MaxSessions < 0 || limits.IdleTimeout < 0 || limits.MaxLifetime < 0 || limits.WarnBefore < 0 {
+		log.Fatalf("session limits must not be negative")
+	}
+
+	// Sessions must outlive the client that spawned us. If the Electron
+	// process dies, our stdout/stderr pipes break; handling SIGPIPE turns
+	// the next log write into an error instead of killing every shell.
+	// Unlike ignoring it, a handled signal is reset to its default in the
+	// shells we start, so pipelines such as `yes | head -1` still end.
+	signal.Notify(make(chan os.Signal, 1), syscall.SIGPIPE)
+
 	// Listen on a TCP port (could be unix socket in production)
 	lis, err := net.Listen("tcp", ":50051")
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
-	grpcServer := grpc.NewServer()
-
 	// --- Storage & History ------------------------------------------------
 	// Store the DB in ~/.blockterm/ so it persists across app restarts.
 	homedir, err := os.UserHomeDir()
@@ -48,14 +63,36 @@ func main() {
 	if err := os.MkdirAll(dbDir, 0o700); err != nil {
 		log.Fatalf("failed to create db directory: %v", err)
 	}
+
+	// Clients read the server token from next to the DB; anything but the
+	// RPCs scoped to a session by its own tokens requires it.
+	serverToken, err := server.WriteServerToken(filepath.Join(dbDir, "server-token"))
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+	auth := server.NewAuth(serverToken)
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(auth.UnaryInterceptor),
+		grpc.StreamInterceptor(auth.StreamInterceptor),
+	)
 	db, err := storage.NewDB(filepath.Join(dbDir, "history.db"))
 	if err != nil {
 		log.Fatalf("failed to open history database: %v", err)
 	}
 	historySvc := history.NewService(db)
 
-	// Initialize session manager
+	// Initialize session manager; commands reported by the shell
+	// integration are written to history as they run.
 	sessionMgr := session.NewManager()
+	sessionMgr.SetCommandRecorder(historySvc)
+	sessionMgr.SetLimits(*limits)
+
+	// Session recordings (asciicast v2) live next to the history DB.
+	recordingStore, err := recording.NewStore(filepath.Join(dbDir, "recordings"))
+	if err != nil {
+		log.Fatalf("failed to open recordings: %v", err)
+	}
+	sessionMgr.SetRecordingStore(recordingStore)
 
 	// Initialize suggestion providers
 	staticProvider := suggest.NewStaticProvider()
@@ -69,13 +106,25 @@ func main() {
 		staticProvider,     // Static command suggestions (lowest priority)
 	)
 	sessionService := session.NewService(sessionMgr)
+	sessionService.SetProfileStore(db)
 	systemService := system.New(version, build)
 
+	// Output triggers are stored next to the profiles and evaluated by the
+	// session manager.
+	triggerServer := server.NewTriggerServer(db, sessionMgr)
+	if err := triggerServer.LoadTriggers(context.Background()); err != nil {
+		log.Printf("failed to load triggers: %v", err)
+	}
+
 	// Register gRPC service implementations
 	pb.RegisterSuggestionServiceServer(grpcServer, suggestionService)
 	pb.RegisterTerminalServiceServer(grpcServer, sessionService)
 	pb.RegisterSystemServiceServer(grpcServer, systemService)
-	pb.RegisterHistoryServiceServer(grpcServer, server.NewHistoryServer(historySvc))
+	pb.RegisterHistoryServiceServer(grpcServer, server.NewHistoryServer(historySvc, sessionMgr))
+	pb.RegisterRecordingServiceServer(grpcServer, server.NewRecordingServer(recordingStore))
+	pb.RegisterPlaybackServiceServer(grpcServer, server.NewPlaybackServer(recordingStore))
+	pb.RegisterProfileServiceServer(grpcServer, server.NewProfileServer(db))
+	pb.RegisterTriggerServiceServer(grpcServer, triggerServer)
 
 	// Graceful shutdown handling
 	quit := make(chan os.Signal, 1)
@@ -90,6 +139,11 @@ func main() {
 
 	<-quit
 	log.Println("Shutting down gRPC server...")
+	// Sessions go first: their output streams only end once the shells do,
+	// and GracefulStop waits for open streams.
+	if err := sessionMgr.Close(); err != nil {
+		log.Printf("session manager close error: %v", err)
+	}
 	grpcServer.GracefulStop()
 	if err := historySvc.Close(); err != nil {
 		log.Printf("history service close error: %v", err)
@@ -99,3 +153,19 @@ func main() {
 	}
 	log.Println("Server stopped.")
 }
+
+// sessionLimitFlags defines the command-line flags that enable session
+// limits, e.g. -max-sessions=64 -idle-timeout=12h, and returns the limits
+// they fill in once flag.Parse has run. Every limit is off unless set.
+func sessionLimitFlags() *session.Limits {
+	limits := session.DefaultLimits
+	flag.IntVar(&limits.MaxSessions, "max-sessions", limits.MaxSessions,
+		"maximum number of sessions running at once (0 = unlimited)")
+	flag.DurationVar(&limits.IdleTimeout, "idle-timeout", limits.IdleTimeout,
+		"close detached sessions without input or output for this long (0 = never)")
+	flag.DurationVar(&limits.MaxLifetime, "max-lifetime", limits.MaxLifetime,
+		"close sessions this long after they started (0 = never)")
+	flag.DurationVar(&limits.WarnBefore, "limit-warning", limits.WarnBefore,
+		"how long before a limit closes a session to warn about it")
+	return &limits
+}
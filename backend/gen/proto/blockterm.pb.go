@@ -27,9 +27,23 @@ type StartSessionRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Shell string            `protobuf:"bytes,1,opt,name=shell,proto3" json:"shell,omitempty"`                                                                                     // "bash", "zsh", "powershell"
-	Cwd   string            `protobuf:"bytes,2,opt,name=cwd,proto3" json:"cwd,omitempty"`                                                                                         // starting directory
-	Env   map[string]string `protobuf:"bytes,3,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // environment overrides
+	Shell          string            `protobuf:"bytes,1,opt,name=shell,proto3" json:"shell,omitempty"`                                                                                     // "bash", "zsh", "powershell"
+	Cwd            string            `protobuf:"bytes,2,opt,name=cwd,proto3" json:"cwd,omitempty"`                                                                                         // starting directory
+	Env            map[string]string `protobuf:"bytes,3,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // environment overrides
+	MarkerProtocol string            `protobuf:"bytes,4,opt,name=marker_protocol,json=markerProtocol,proto3" json:"marker_protocol,omitempty"`                                             // "blockterm" (default) or "osc133"
+	Record         bool              `protobuf:"varint,5,opt,name=record,proto3" json:"record,omitempty"`                                                                                  // record the session as asciicast v2 (see RecordingService)
+	Cols           uint32            `protobuf:"varint,6,opt,name=cols,proto3" json:"cols,omitempty"`                                                                                      // initial size (0 = 80x24)
+	Rows           uint32            `protobuf:"varint,7,opt,name=rows,proto3" json:"rows,omitempty"`
+	Args           []string          `protobuf:"bytes,8,rep,name=args,proto3" json:"args,omitempty"`    // extra arguments for the shell, after BlockTerm's own
+	Login          bool              `protobuf:"varint,9,opt,name=login,proto3" json:"login,omitempty"` // start the shell as a login shell
+	Term           string            `protobuf:"bytes,10,opt,name=term,proto3" json:"term,omitempty"`   // TERM for the session (default "xterm-256color")
+	// Runs this program (argv) directly under the PTY instead of a shell, with
+	// no shell integration; the session ends when it exits. Excludes shell,
+	// args and login.
+	Command []string `protobuf:"bytes,11,rep,name=command,proto3" json:"command,omitempty"`
+	// Starts from a stored profile. Fields set in this request override the
+	// profile's; env is merged, with this request's entries winning.
+	ProfileId string `protobuf:"bytes,12,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
 }
 
 func (x *StartSessionRequest) Reset() {
@@ -85,12 +99,76 @@ func (x *StartSessionRequest) GetEnv() map[string]string {
 	return nil
 }
 
+func (x *StartSessionRequest) GetMarkerProtocol() string {
+	if x != nil {
+		return x.MarkerProtocol
+	}
+	return ""
+}
+
+func (x *StartSessionRequest) GetRecord() bool {
+	if x != nil {
+		return x.Record
+	}
+	return false
+}
+
+func (x *StartSessionRequest) GetCols() uint32 {
+	if x != nil {
+		return x.Cols
+	}
+	return 0
+}
+
+func (x *StartSessionRequest) GetRows() uint32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *StartSessionRequest) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *StartSessionRequest) GetLogin() bool {
+	if x != nil {
+		return x.Login
+	}
+	return false
+}
+
+func (x *StartSessionRequest) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *StartSessionRequest) GetCommand() []string {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
+func (x *StartSessionRequest) GetProfileId() string {
+	if x != nil {
+		return x.ProfileId
+	}
+	return ""
+}
+
 type StartSessionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	SessionId  string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	OwnerToken string `protobuf:"bytes,2,opt,name=owner_token,json=ownerToken,proto3" json:"owner_token,omitempty"` // secret; pass it as share_token to act as the session's owner
 }
 
 func (x *StartSessionResponse) Reset() {
@@ -132,12 +210,21 @@ func (x *StartSessionResponse) GetSessionId() string {
 	return ""
 }
 
+func (x *StartSessionResponse) GetOwnerToken() string {
+	if x != nil {
+		return x.OwnerToken
+	}
+	return ""
+}
+
 type CloseSessionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	SessionId     string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	GracePeriodMs uint32 `protobuf:"varint,2,opt,name=grace_period_ms,json=gracePeriodMs,proto3" json:"grace_period_ms,omitempty"` // wait after SIGHUP, and again after SIGTERM (0 = server default)
+	ShareToken    string `protobuf:"bytes,3,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"`             // the owner_token
 }
 
 func (x *CloseSessionRequest) Reset() {
@@ -179,17 +266,31 @@ func (x *CloseSessionRequest) GetSessionId() string {
 	return ""
 }
 
-type InputChunk struct {
+func (x *CloseSessionRequest) GetGracePeriodMs() uint32 {
+	if x != nil {
+		return x.GracePeriodMs
+	}
+	return 0
+}
+
+func (x *CloseSessionRequest) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+type CloseSessionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	Data      []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"` // raw terminal input
+	Ok     bool             `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"` // wire-compatible with Ack
+	Killed []*KilledProcess `protobuf:"bytes,2,rep,name=killed,proto3" json:"killed,omitempty"`
 }
 
-func (x *InputChunk) Reset() {
-	*x = InputChunk{}
+func (x *CloseSessionResponse) Reset() {
+	*x = CloseSessionResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_blockterm_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -197,13 +298,13 @@ func (x *InputChunk) Reset() {
 	}
 }
 
-func (x *InputChunk) String() string {
+func (x *CloseSessionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InputChunk) ProtoMessage() {}
+func (*CloseSessionResponse) ProtoMessage() {}
 
-func (x *InputChunk) ProtoReflect() protoreflect.Message {
+func (x *CloseSessionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_blockterm_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -215,35 +316,37 @@ func (x *InputChunk) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InputChunk.ProtoReflect.Descriptor instead.
-func (*InputChunk) Descriptor() ([]byte, []int) {
+// Deprecated: Use CloseSessionResponse.ProtoReflect.Descriptor instead.
+func (*CloseSessionResponse) Descriptor() ([]byte, []int) {
 	return file_blockterm_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *InputChunk) GetSessionId() string {
+func (x *CloseSessionResponse) GetOk() bool {
 	if x != nil {
-		return x.SessionId
+		return x.Ok
 	}
-	return ""
+	return false
 }
 
-func (x *InputChunk) GetData() []byte {
+func (x *CloseSessionResponse) GetKilled() []*KilledProcess {
 	if x != nil {
-		return x.Data
+		return x.Killed
 	}
 	return nil
 }
 
-type ReceiveOutputRequest struct {
+type KilledProcess struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Process  *ProcessInfo `protobuf:"bytes,1,opt,name=process,proto3" json:"process,omitempty"`
+	Signal   string       `protobuf:"bytes,2,opt,name=signal,proto3" json:"signal,omitempty"`      // last signal sent before it ended: "SIGHUP", "SIGTERM" or "SIGKILL"
+	Survived bool         `protobuf:"varint,3,opt,name=survived,proto3" json:"survived,omitempty"` // still alive after SIGKILL (e.g. in uninterruptible sleep)
 }
 
-func (x *ReceiveOutputRequest) Reset() {
-	*x = ReceiveOutputRequest{}
+func (x *KilledProcess) Reset() {
+	*x = KilledProcess{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_blockterm_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -251,13 +354,13 @@ func (x *ReceiveOutputRequest) Reset() {
 	}
 }
 
-func (x *ReceiveOutputRequest) String() string {
+func (x *KilledProcess) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReceiveOutputRequest) ProtoMessage() {}
+func (*KilledProcess) ProtoMessage() {}
 
-func (x *ReceiveOutputRequest) ProtoReflect() protoreflect.Message {
+func (x *KilledProcess) ProtoReflect() protoreflect.Message {
 	mi := &file_blockterm_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -269,29 +372,44 @@ func (x *ReceiveOutputRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReceiveOutputRequest.ProtoReflect.Descriptor instead.
-func (*ReceiveOutputRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use KilledProcess.ProtoReflect.Descriptor instead.
+func (*KilledProcess) Descriptor() ([]byte, []int) {
 	return file_blockterm_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ReceiveOutputRequest) GetSessionId() string {
+func (x *KilledProcess) GetProcess() *ProcessInfo {
 	if x != nil {
-		return x.SessionId
+		return x.Process
+	}
+	return nil
+}
+
+func (x *KilledProcess) GetSignal() string {
+	if x != nil {
+		return x.Signal
 	}
 	return ""
 }
 
-type OutputChunk struct {
+func (x *KilledProcess) GetSurvived() bool {
+	if x != nil {
+		return x.Survived
+	}
+	return false
+}
+
+type InputChunk struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	Data      []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"` // raw terminal output
+	SessionId  string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Data       []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`                               // raw terminal input
+	ShareToken string `protobuf:"bytes,3,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"` // owner_token or a share token
 }
 
-func (x *OutputChunk) Reset() {
-	*x = OutputChunk{}
+func (x *InputChunk) Reset() {
+	*x = InputChunk{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_blockterm_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -299,13 +417,13 @@ func (x *OutputChunk) Reset() {
 	}
 }
 
-func (x *OutputChunk) String() string {
+func (x *InputChunk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OutputChunk) ProtoMessage() {}
+func (*InputChunk) ProtoMessage() {}
 
-func (x *OutputChunk) ProtoReflect() protoreflect.Message {
+func (x *InputChunk) ProtoReflect() protoreflect.Message {
 	mi := &file_blockterm_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -317,37 +435,46 @@ func (x *OutputChunk) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OutputChunk.ProtoReflect.Descriptor instead.
-func (*OutputChunk) Descriptor() ([]byte, []int) {
+// Deprecated: Use InputChunk.ProtoReflect.Descriptor instead.
+func (*InputChunk) Descriptor() ([]byte, []int) {
 	return file_blockterm_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *OutputChunk) GetSessionId() string {
+func (x *InputChunk) GetSessionId() string {
 	if x != nil {
 		return x.SessionId
 	}
 	return ""
 }
 
-func (x *OutputChunk) GetData() []byte {
+func (x *InputChunk) GetData() []byte {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type ResizeSessionRequest struct {
+func (x *InputChunk) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+type ReceiveOutputRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	Cols      uint32 `protobuf:"varint,2,opt,name=cols,proto3" json:"cols,omitempty"`
-	Rows      uint32 `protobuf:"varint,3,opt,name=rows,proto3" json:"rows,omitempty"`
+	SessionId      string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	FromOffset     uint64 `protobuf:"varint,2,opt,name=from_offset,json=fromOffset,proto3" json:"from_offset,omitempty"`            // replay retained scrollback from this byte offset (0 = everything retained)
+	OverflowPolicy string `protobuf:"bytes,3,opt,name=overflow_policy,json=overflowPolicy,proto3" json:"overflow_policy,omitempty"` // when the client falls behind: "drop" (default) or "disconnect"
+	ShareToken     string `protobuf:"bytes,4,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"`             // owner_token or a share token
+	AfterSeq       uint64 `protobuf:"varint,5,opt,name=after_seq,json=afterSeq,proto3" json:"after_seq,omitempty"`                  // resume after the chunk with this seq instead; nothing already received is replayed
 }
 
-func (x *ResizeSessionRequest) Reset() {
-	*x = ResizeSessionRequest{}
+func (x *ReceiveOutputRequest) Reset() {
+	*x = ReceiveOutputRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_blockterm_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -355,13 +482,13 @@ func (x *ResizeSessionRequest) Reset() {
 	}
 }
 
-func (x *ResizeSessionRequest) String() string {
+func (x *ReceiveOutputRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResizeSessionRequest) ProtoMessage() {}
+func (*ReceiveOutputRequest) ProtoMessage() {}
 
-func (x *ResizeSessionRequest) ProtoReflect() protoreflect.Message {
+func (x *ReceiveOutputRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_blockterm_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -373,44 +500,70 @@ func (x *ResizeSessionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResizeSessionRequest.ProtoReflect.Descriptor instead.
-func (*ResizeSessionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReceiveOutputRequest.ProtoReflect.Descriptor instead.
+func (*ReceiveOutputRequest) Descriptor() ([]byte, []int) {
 	return file_blockterm_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ResizeSessionRequest) GetSessionId() string {
+func (x *ReceiveOutputRequest) GetSessionId() string {
 	if x != nil {
 		return x.SessionId
 	}
 	return ""
 }
 
-func (x *ResizeSessionRequest) GetCols() uint32 {
+func (x *ReceiveOutputRequest) GetFromOffset() uint64 {
 	if x != nil {
-		return x.Cols
+		return x.FromOffset
 	}
 	return 0
 }
 
-func (x *ResizeSessionRequest) GetRows() uint32 {
+func (x *ReceiveOutputRequest) GetOverflowPolicy() string {
 	if x != nil {
-		return x.Rows
+		return x.OverflowPolicy
+	}
+	return ""
+}
+
+func (x *ReceiveOutputRequest) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+func (x *ReceiveOutputRequest) GetAfterSeq() uint64 {
+	if x != nil {
+		return x.AfterSeq
 	}
 	return 0
 }
 
-type GetSuggestionsRequest struct {
+type OutputChunk struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	Input     string `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
-	CursorPos uint32 `protobuf:"varint,3,opt,name=cursor_pos,json=cursorPos,proto3" json:"cursor_pos,omitempty"`
+	SessionId string              `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Data      []byte              `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`                            // raw terminal output
+	CommandId string              `protobuf:"bytes,3,opt,name=command_id,json=commandId,proto3" json:"command_id,omitempty"` // unique identifier for the current command block
+	Status    string              `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`                        // "running", "completed", "failed"
+	ExitCode  int32               `protobuf:"varint,5,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`   // exit code (only set when status is completed/failed)
+	Offset    uint64              `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`                       // byte offset of data within the session's output stream
+	Command   string              `protobuf:"bytes,7,opt,name=command,proto3" json:"command,omitempty"`                      // command line reported by the shell (only on the chunk starting a block)
+	State     *SessionStateChange `protobuf:"bytes,8,opt,name=state,proto3" json:"state,omitempty"`                          // set on data-less chunks when the foreground process or screen changes
+	Dropped   uint32              `protobuf:"varint,9,opt,name=dropped,proto3" json:"dropped,omitempty"`                     // chunks dropped before this one because the client fell behind
+	Resize    *TerminalSize       `protobuf:"bytes,10,opt,name=resize,proto3" json:"resize,omitempty"`                       // set on data-less chunks when the terminal was resized; later output uses the new size
+	Exit      *SessionExit        `protobuf:"bytes,11,opt,name=exit,proto3" json:"exit,omitempty"`                           // set on the final chunk of a stream whose session ended
+	Event     *SessionEvent       `protobuf:"bytes,12,opt,name=event,proto3" json:"event,omitempty"`                         // set on data-less chunks warning that a limit will close the session
+	Trigger   *TriggerHit         `protobuf:"bytes,13,opt,name=trigger,proto3" json:"trigger,omitempty"`                     // set on data-less chunks reporting that a trigger matched earlier output
+	Seq       uint64              `protobuf:"varint,14,opt,name=seq,proto3" json:"seq,omitempty"`                            // position of the chunk in the session's output, from 1; resume with after_seq
+	FailedBy  string              `protobuf:"bytes,15,opt,name=failed_by,json=failedBy,proto3" json:"failed_by,omitempty"`   // with status "failed": the fail_block trigger that failed a block whose command succeeded, exit_code 0
 }
 
-func (x *GetSuggestionsRequest) Reset() {
-	*x = GetSuggestionsRequest{}
+func (x *OutputChunk) Reset() {
+	*x = OutputChunk{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_blockterm_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -418,13 +571,13 @@ func (x *GetSuggestionsRequest) Reset() {
 	}
 }
 
-func (x *GetSuggestionsRequest) String() string {
+func (x *OutputChunk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSuggestionsRequest) ProtoMessage() {}
+func (*OutputChunk) ProtoMessage() {}
 
-func (x *GetSuggestionsRequest) ProtoReflect() protoreflect.Message {
+func (x *OutputChunk) ProtoReflect() protoreflect.Message {
 	mi := &file_blockterm_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -436,120 +589,144 @@ func (x *GetSuggestionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSuggestionsRequest.ProtoReflect.Descriptor instead.
-func (*GetSuggestionsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use OutputChunk.ProtoReflect.Descriptor instead.
+func (*OutputChunk) Descriptor() ([]byte, []int) {
 	return file_blockterm_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetSuggestionsRequest) GetSessionId() string {
+func (x *OutputChunk) GetSessionId() string {
 	if x != nil {
 		return x.SessionId
 	}
 	return ""
 }
 
-func (x *GetSuggestionsRequest) GetInput() string {
+func (x *OutputChunk) GetData() []byte {
 	if x != nil {
-		return x.Input
+		return x.Data
+	}
+	return nil
+}
+
+func (x *OutputChunk) GetCommandId() string {
+	if x != nil {
+		return x.CommandId
 	}
 	return ""
 }
 
-func (x *GetSuggestionsRequest) GetCursorPos() uint32 {
+func (x *OutputChunk) GetStatus() string {
 	if x != nil {
-		return x.CursorPos
+		return x.Status
 	}
-	return 0
+	return ""
 }
 
-type Suggestion struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *OutputChunk) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
 
-	Text   string  `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
-	Source string  `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"` // "history", "filesystem", "static", "ai"
-	Score  float32 `protobuf:"fixed32,3,opt,name=score,proto3" json:"score,omitempty"`
+func (x *OutputChunk) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
 }
 
-func (x *Suggestion) Reset() {
-	*x = Suggestion{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_blockterm_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *OutputChunk) GetCommand() string {
+	if x != nil {
+		return x.Command
 	}
+	return ""
 }
 
-func (x *Suggestion) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *OutputChunk) GetState() *SessionStateChange {
+	if x != nil {
+		return x.State
+	}
+	return nil
 }
 
-func (*Suggestion) ProtoMessage() {}
+func (x *OutputChunk) GetDropped() uint32 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
 
-func (x *Suggestion) ProtoReflect() protoreflect.Message {
-	mi := &file_blockterm_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *OutputChunk) GetResize() *TerminalSize {
+	if x != nil {
+		return x.Resize
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use Suggestion.ProtoReflect.Descriptor instead.
-func (*Suggestion) Descriptor() ([]byte, []int) {
-	return file_blockterm_proto_rawDescGZIP(), []int{8}
+func (x *OutputChunk) GetExit() *SessionExit {
+	if x != nil {
+		return x.Exit
+	}
+	return nil
 }
 
-func (x *Suggestion) GetText() string {
+func (x *OutputChunk) GetEvent() *SessionEvent {
 	if x != nil {
-		return x.Text
+		return x.Event
 	}
-	return ""
+	return nil
 }
 
-func (x *Suggestion) GetSource() string {
+func (x *OutputChunk) GetTrigger() *TriggerHit {
 	if x != nil {
-		return x.Source
+		return x.Trigger
 	}
-	return ""
+	return nil
 }
 
-func (x *Suggestion) GetScore() float32 {
+func (x *OutputChunk) GetSeq() uint64 {
 	if x != nil {
-		return x.Score
+		return x.Seq
 	}
 	return 0
 }
 
-type GetSuggestionsResponse struct {
+func (x *OutputChunk) GetFailedBy() string {
+	if x != nil {
+		return x.FailedBy
+	}
+	return ""
+}
+
+type SessionExit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Suggestions []*Suggestion `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	ExitCode     int32  `protobuf:"varint,1,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`               // 128+n when killed by signal n
+	Signal       string `protobuf:"bytes,2,opt,name=signal,proto3" json:"signal,omitempty"`                                    // terminating signal, e.g. "SIGKILL"; empty if the shell exited
+	ClosedByUser bool   `protobuf:"varint,3,opt,name=closed_by_user,json=closedByUser,proto3" json:"closed_by_user,omitempty"` // ended through CloseSession
+	Reaped       string `protobuf:"bytes,4,opt,name=reaped,proto3" json:"reaped,omitempty"`                                    // "idle" or "lifetime" if a session limit closed it
 }
 
-func (x *GetSuggestionsResponse) Reset() {
-	*x = GetSuggestionsResponse{}
+func (x *SessionExit) Reset() {
+	*x = SessionExit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_blockterm_proto_msgTypes[9]
+		mi := &file_blockterm_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetSuggestionsResponse) String() string {
+func (x *SessionExit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSuggestionsResponse) ProtoMessage() {}
+func (*SessionExit) ProtoMessage() {}
 
-func (x *GetSuggestionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_blockterm_proto_msgTypes[9]
+func (x *SessionExit) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -560,47 +737,71 @@ func (x *GetSuggestionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSuggestionsResponse.ProtoReflect.Descriptor instead.
-func (*GetSuggestionsResponse) Descriptor() ([]byte, []int) {
-	return file_blockterm_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use SessionExit.ProtoReflect.Descriptor instead.
+func (*SessionExit) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetSuggestionsResponse) GetSuggestions() []*Suggestion {
+func (x *SessionExit) GetExitCode() int32 {
 	if x != nil {
-		return x.Suggestions
+		return x.ExitCode
 	}
-	return nil
+	return 0
 }
 
-type RecordCommandRequest struct {
+func (x *SessionExit) GetSignal() string {
+	if x != nil {
+		return x.Signal
+	}
+	return ""
+}
+
+func (x *SessionExit) GetClosedByUser() bool {
+	if x != nil {
+		return x.ClosedByUser
+	}
+	return false
+}
+
+func (x *SessionExit) GetReaped() string {
+	if x != nil {
+		return x.Reaped
+	}
+	return ""
+}
+
+type WaitForOutputRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	Command   string `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`
-	Cwd       string `protobuf:"bytes,3,opt,name=cwd,proto3" json:"cwd,omitempty"`
-	ExitCode  int32  `protobuf:"varint,4,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
-	Timestamp int64  `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	SessionId     string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Pattern       string `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`                                   // RE2 regular expression
+	Literal       string `protobuf:"bytes,3,opt,name=literal,proto3" json:"literal,omitempty"`                                   // exact text; instead of pattern
+	BlockComplete bool   `protobuf:"varint,4,opt,name=block_complete,json=blockComplete,proto3" json:"block_complete,omitempty"` // stop when a command block completes
+	CommandId     string `protobuf:"bytes,5,opt,name=command_id,json=commandId,proto3" json:"command_id,omitempty"`              // with block_complete, only this block counts
+	FromOffset    uint64 `protobuf:"varint,6,opt,name=from_offset,json=fromOffset,proto3" json:"from_offset,omitempty"`          // output offset to start at (0 = everything retained)
+	TimeoutMs     uint32 `protobuf:"varint,7,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`             // 0 = none; the call's deadline still applies
+	ShareToken    string `protobuf:"bytes,8,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"`           // owner_token or a share token
 }
 
-func (x *RecordCommandRequest) Reset() {
-	*x = RecordCommandRequest{}
+func (x *WaitForOutputRequest) Reset() {
+	*x = WaitForOutputRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_blockterm_proto_msgTypes[10]
+		mi := &file_blockterm_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RecordCommandRequest) String() string {
+func (x *WaitForOutputRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecordCommandRequest) ProtoMessage() {}
+func (*WaitForOutputRequest) ProtoMessage() {}
 
-func (x *RecordCommandRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_blockterm_proto_msgTypes[10]
+func (x *WaitForOutputRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -611,72 +812,101 @@ func (x *RecordCommandRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecordCommandRequest.ProtoReflect.Descriptor instead.
-func (*RecordCommandRequest) Descriptor() ([]byte, []int) {
-	return file_blockterm_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use WaitForOutputRequest.ProtoReflect.Descriptor instead.
+func (*WaitForOutputRequest) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *RecordCommandRequest) GetSessionId() string {
+func (x *WaitForOutputRequest) GetSessionId() string {
 	if x != nil {
 		return x.SessionId
 	}
 	return ""
 }
 
-func (x *RecordCommandRequest) GetCommand() string {
+func (x *WaitForOutputRequest) GetPattern() string {
 	if x != nil {
-		return x.Command
+		return x.Pattern
 	}
 	return ""
 }
 
-func (x *RecordCommandRequest) GetCwd() string {
+func (x *WaitForOutputRequest) GetLiteral() string {
 	if x != nil {
-		return x.Cwd
+		return x.Literal
 	}
 	return ""
 }
 
-func (x *RecordCommandRequest) GetExitCode() int32 {
+func (x *WaitForOutputRequest) GetBlockComplete() bool {
 	if x != nil {
-		return x.ExitCode
+		return x.BlockComplete
+	}
+	return false
+}
+
+func (x *WaitForOutputRequest) GetCommandId() string {
+	if x != nil {
+		return x.CommandId
+	}
+	return ""
+}
+
+func (x *WaitForOutputRequest) GetFromOffset() uint64 {
+	if x != nil {
+		return x.FromOffset
 	}
 	return 0
 }
 
-func (x *RecordCommandRequest) GetTimestamp() int64 {
+func (x *WaitForOutputRequest) GetTimeoutMs() uint32 {
 	if x != nil {
-		return x.Timestamp
+		return x.TimeoutMs
 	}
 	return 0
 }
 
-type QueryHistoryRequest struct {
+func (x *WaitForOutputRequest) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+type WaitForOutputResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	Limit uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Reason    string       `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`                         // "match", "block_complete", "timeout" or "exited"
+	Matched   []byte       `protobuf:"bytes,2,opt,name=matched,proto3" json:"matched,omitempty"`                       // text that matched
+	Groups    []string     `protobuf:"bytes,3,rep,name=groups,proto3" json:"groups,omitempty"`                         // the pattern's submatches
+	Offset    uint64       `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`                        // start of the match, or where the block completed
+	EndOffset uint64       `protobuf:"varint,5,opt,name=end_offset,json=endOffset,proto3" json:"end_offset,omitempty"` // end of the match, or of the output seen; wait from here next
+	CommandId string       `protobuf:"bytes,6,opt,name=command_id,json=commandId,proto3" json:"command_id,omitempty"`  // the completed block
+	Status    string       `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`                         // "completed" or "failed"
+	ExitCode  int32        `protobuf:"varint,8,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	Exit      *SessionExit `protobuf:"bytes,9,opt,name=exit,proto3" json:"exit,omitempty"`                          // how the shell ended, for "exited"
+	FailedBy  string       `protobuf:"bytes,10,opt,name=failed_by,json=failedBy,proto3" json:"failed_by,omitempty"` // the fail_block trigger that failed the block, as in OutputChunk
 }
 
-func (x *QueryHistoryRequest) Reset() {
-	*x = QueryHistoryRequest{}
+func (x *WaitForOutputResponse) Reset() {
+	*x = WaitForOutputResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_blockterm_proto_msgTypes[11]
+		mi := &file_blockterm_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryHistoryRequest) String() string {
+func (x *WaitForOutputResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryHistoryRequest) ProtoMessage() {}
+func (*WaitForOutputResponse) ProtoMessage() {}
 
-func (x *QueryHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_blockterm_proto_msgTypes[11]
+func (x *WaitForOutputResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -687,97 +917,108 @@ func (x *QueryHistoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryHistoryRequest.ProtoReflect.Descriptor instead.
-func (*QueryHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_blockterm_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use WaitForOutputResponse.ProtoReflect.Descriptor instead.
+func (*WaitForOutputResponse) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *QueryHistoryRequest) GetQuery() string {
+func (x *WaitForOutputResponse) GetReason() string {
 	if x != nil {
-		return x.Query
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *QueryHistoryRequest) GetLimit() uint32 {
+func (x *WaitForOutputResponse) GetMatched() []byte {
 	if x != nil {
-		return x.Limit
+		return x.Matched
 	}
-	return 0
+	return nil
 }
 
-type QueryHistoryResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Entries []*RecordCommandRequest `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+func (x *WaitForOutputResponse) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
 }
 
-func (x *QueryHistoryResponse) Reset() {
-	*x = QueryHistoryResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_blockterm_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *WaitForOutputResponse) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
 	}
+	return 0
 }
 
-func (x *QueryHistoryResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *WaitForOutputResponse) GetEndOffset() uint64 {
+	if x != nil {
+		return x.EndOffset
+	}
+	return 0
 }
 
-func (*QueryHistoryResponse) ProtoMessage() {}
+func (x *WaitForOutputResponse) GetCommandId() string {
+	if x != nil {
+		return x.CommandId
+	}
+	return ""
+}
 
-func (x *QueryHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_blockterm_proto_msgTypes[12]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *WaitForOutputResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use QueryHistoryResponse.ProtoReflect.Descriptor instead.
-func (*QueryHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_blockterm_proto_rawDescGZIP(), []int{12}
+func (x *WaitForOutputResponse) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
 }
 
-func (x *QueryHistoryResponse) GetEntries() []*RecordCommandRequest {
+func (x *WaitForOutputResponse) GetExit() *SessionExit {
 	if x != nil {
-		return x.Entries
+		return x.Exit
 	}
 	return nil
 }
 
-type SaveLayoutRequest struct {
+func (x *WaitForOutputResponse) GetFailedBy() string {
+	if x != nil {
+		return x.FailedBy
+	}
+	return ""
+}
+
+type ShareSessionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	JsonLayout []byte `protobuf:"bytes,1,opt,name=json_layout,json=jsonLayout,proto3" json:"json_layout,omitempty"` // serialized layout JSON
+	SessionId  string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Role       string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`                               // "interactive" or "read_only" (default)
+	ShareToken string `protobuf:"bytes,3,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"` // the owner_token
 }
 
-func (x *SaveLayoutRequest) Reset() {
-	*x = SaveLayoutRequest{}
+func (x *ShareSessionRequest) Reset() {
+	*x = ShareSessionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_blockterm_proto_msgTypes[13]
+		mi := &file_blockterm_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SaveLayoutRequest) String() string {
+func (x *ShareSessionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveLayoutRequest) ProtoMessage() {}
+func (*ShareSessionRequest) ProtoMessage() {}
 
-func (x *SaveLayoutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_blockterm_proto_msgTypes[13]
+func (x *ShareSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -788,43 +1029,58 @@ func (x *SaveLayoutRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveLayoutRequest.ProtoReflect.Descriptor instead.
-func (*SaveLayoutRequest) Descriptor() ([]byte, []int) {
-	return file_blockterm_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ShareSessionRequest.ProtoReflect.Descriptor instead.
+func (*ShareSessionRequest) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *SaveLayoutRequest) GetJsonLayout() []byte {
+func (x *ShareSessionRequest) GetSessionId() string {
 	if x != nil {
-		return x.JsonLayout
+		return x.SessionId
 	}
-	return nil
+	return ""
 }
 
-type LoadLayoutResponse struct {
+func (x *ShareSessionRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ShareSessionRequest) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+type ShareSessionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	JsonLayout []byte `protobuf:"bytes,1,opt,name=json_layout,json=jsonLayout,proto3" json:"json_layout,omitempty"`
+	Share      *SessionShare `protobuf:"bytes,1,opt,name=share,proto3" json:"share,omitempty"`
+	ShareToken string        `protobuf:"bytes,2,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"` // secret; give it to one collaborator
 }
 
-func (x *LoadLayoutResponse) Reset() {
-	*x = LoadLayoutResponse{}
+func (x *ShareSessionResponse) Reset() {
+	*x = ShareSessionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_blockterm_proto_msgTypes[14]
+		mi := &file_blockterm_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LoadLayoutResponse) String() string {
+func (x *ShareSessionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoadLayoutResponse) ProtoMessage() {}
+func (*ShareSessionResponse) ProtoMessage() {}
 
-func (x *LoadLayoutResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_blockterm_proto_msgTypes[14]
+func (x *ShareSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -835,43 +1091,52 @@ func (x *LoadLayoutResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoadLayoutResponse.ProtoReflect.Descriptor instead.
-func (*LoadLayoutResponse) Descriptor() ([]byte, []int) {
-	return file_blockterm_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ShareSessionResponse.ProtoReflect.Descriptor instead.
+func (*ShareSessionResponse) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *LoadLayoutResponse) GetJsonLayout() []byte {
+func (x *ShareSessionResponse) GetShare() *SessionShare {
 	if x != nil {
-		return x.JsonLayout
+		return x.Share
 	}
 	return nil
 }
 
-type PingRequest struct {
+func (x *ShareSessionResponse) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+type RevokeShareRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	SessionId  string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	ShareId    string `protobuf:"bytes,2,opt,name=share_id,json=shareId,proto3" json:"share_id,omitempty"`          // empty revokes every share of the session
+	ShareToken string `protobuf:"bytes,3,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"` // the owner_token
 }
 
-func (x *PingRequest) Reset() {
-	*x = PingRequest{}
+func (x *RevokeShareRequest) Reset() {
+	*x = RevokeShareRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_blockterm_proto_msgTypes[15]
+		mi := &file_blockterm_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PingRequest) String() string {
+func (x *RevokeShareRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PingRequest) ProtoMessage() {}
+func (*RevokeShareRequest) ProtoMessage() {}
 
-func (x *PingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_blockterm_proto_msgTypes[15]
+func (x *RevokeShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -882,43 +1147,61 @@ func (x *PingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PingRequest.ProtoReflect.Descriptor instead.
-func (*PingRequest) Descriptor() ([]byte, []int) {
-	return file_blockterm_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use RevokeShareRequest.ProtoReflect.Descriptor instead.
+func (*RevokeShareRequest) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *PingRequest) GetMessage() string {
+func (x *RevokeShareRequest) GetSessionId() string {
 	if x != nil {
-		return x.Message
+		return x.SessionId
 	}
 	return ""
 }
 
-type PingResponse struct {
+func (x *RevokeShareRequest) GetShareId() string {
+	if x != nil {
+		return x.ShareId
+	}
+	return ""
+}
+
+func (x *RevokeShareRequest) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+type SessionShare struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	ShareId   string        `protobuf:"bytes,1,opt,name=share_id,json=shareId,proto3" json:"share_id,omitempty"`
+	Role      string        `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`                             // "interactive" or "read_only"
+	CreatedAt int64         `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // unix seconds
+	Streams   uint32        `protobuf:"varint,4,opt,name=streams,proto3" json:"streams,omitempty"`                      // output streams open with the token
+	Size      *TerminalSize `protobuf:"bytes,5,opt,name=size,proto3" json:"size,omitempty"`                             // last size an interactive viewer asked for
 }
 
-func (x *PingResponse) Reset() {
-	*x = PingResponse{}
+func (x *SessionShare) Reset() {
+	*x = SessionShare{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_blockterm_proto_msgTypes[16]
+		mi := &file_blockterm_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PingResponse) String() string {
+func (x *SessionShare) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PingResponse) ProtoMessage() {}
+func (*SessionShare) ProtoMessage() {}
 
-func (x *PingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_blockterm_proto_msgTypes[16]
+func (x *SessionShare) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -929,29 +1212,207 @@ func (x *PingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PingResponse.ProtoReflect.Descriptor instead.
-func (*PingResponse) Descriptor() ([]byte, []int) {
-	return file_blockterm_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use SessionShare.ProtoReflect.Descriptor instead.
+func (*SessionShare) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *PingResponse) GetMessage() string {
+func (x *SessionShare) GetShareId() string {
 	if x != nil {
-		return x.Message
+		return x.ShareId
 	}
 	return ""
 }
 
-type VersionResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *SessionShare) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
 
-	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
-	Build   string `protobuf:"bytes,2,opt,name=build,proto3" json:"build,omitempty"`
+func (x *SessionShare) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
 }
 
-func (x *VersionResponse) Reset() {
-	*x = VersionResponse{}
+func (x *SessionShare) GetStreams() uint32 {
+	if x != nil {
+		return x.Streams
+	}
+	return 0
+}
+
+func (x *SessionShare) GetSize() *TerminalSize {
+	if x != nil {
+		return x.Size
+	}
+	return nil
+}
+
+type BroadcastGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupId     string            `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Name        string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	SessionIds  []string          `protobuf:"bytes,3,rep,name=session_ids,json=sessionIds,proto3" json:"session_ids,omitempty"`
+	CreatedAt   int64             `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                                                                                              // unix seconds
+	ShareTokens map[string]string `protobuf:"bytes,5,rep,name=share_tokens,json=shareTokens,proto3" json:"share_tokens,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // UpdateBroadcastGroup only; as in CreateBroadcastGroupRequest
+	GroupToken  string            `protobuf:"bytes,6,opt,name=group_token,json=groupToken,proto3" json:"group_token,omitempty"`                                                                                            // secret; returned by CreateBroadcastGroup, required by UpdateBroadcastGroup
+}
+
+func (x *BroadcastGroup) Reset() {
+	*x = BroadcastGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BroadcastGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BroadcastGroup) ProtoMessage() {}
+
+func (x *BroadcastGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BroadcastGroup.ProtoReflect.Descriptor instead.
+func (*BroadcastGroup) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BroadcastGroup) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *BroadcastGroup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BroadcastGroup) GetSessionIds() []string {
+	if x != nil {
+		return x.SessionIds
+	}
+	return nil
+}
+
+func (x *BroadcastGroup) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *BroadcastGroup) GetShareTokens() map[string]string {
+	if x != nil {
+		return x.ShareTokens
+	}
+	return nil
+}
+
+func (x *BroadcastGroup) GetGroupToken() string {
+	if x != nil {
+		return x.GroupToken
+	}
+	return ""
+}
+
+type CreateBroadcastGroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	SessionIds  []string          `protobuf:"bytes,2,rep,name=session_ids,json=sessionIds,proto3" json:"session_ids,omitempty"`                                                                                            // must be running sessions
+	ShareTokens map[string]string `protobuf:"bytes,3,rep,name=share_tokens,json=shareTokens,proto3" json:"share_tokens,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // by session ID: its owner_token or an interactive share token
+}
+
+func (x *CreateBroadcastGroupRequest) Reset() {
+	*x = CreateBroadcastGroupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBroadcastGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBroadcastGroupRequest) ProtoMessage() {}
+
+func (x *CreateBroadcastGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBroadcastGroupRequest.ProtoReflect.Descriptor instead.
+func (*CreateBroadcastGroupRequest) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateBroadcastGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateBroadcastGroupRequest) GetSessionIds() []string {
+	if x != nil {
+		return x.SessionIds
+	}
+	return nil
+}
+
+func (x *CreateBroadcastGroupRequest) GetShareTokens() map[string]string {
+	if x != nil {
+		return x.ShareTokens
+	}
+	return nil
+}
+
+type DeleteBroadcastGroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupId    string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	GroupToken string `protobuf:"bytes,2,opt,name=group_token,json=groupToken,proto3" json:"group_token,omitempty"`
+}
+
+func (x *DeleteBroadcastGroupRequest) Reset() {
+	*x = DeleteBroadcastGroupRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_blockterm_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -959,13 +1420,13 @@ func (x *VersionResponse) Reset() {
 	}
 }
 
-func (x *VersionResponse) String() string {
+func (x *DeleteBroadcastGroupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VersionResponse) ProtoMessage() {}
+func (*DeleteBroadcastGroupRequest) ProtoMessage() {}
 
-func (x *VersionResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteBroadcastGroupRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_blockterm_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -977,35 +1438,35 @@ func (x *VersionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VersionResponse.ProtoReflect.Descriptor instead.
-func (*VersionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteBroadcastGroupRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBroadcastGroupRequest) Descriptor() ([]byte, []int) {
 	return file_blockterm_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *VersionResponse) GetVersion() string {
+func (x *DeleteBroadcastGroupRequest) GetGroupId() string {
 	if x != nil {
-		return x.Version
+		return x.GroupId
 	}
 	return ""
 }
 
-func (x *VersionResponse) GetBuild() string {
+func (x *DeleteBroadcastGroupRequest) GetGroupToken() string {
 	if x != nil {
-		return x.Build
+		return x.GroupToken
 	}
 	return ""
 }
 
-type Ack struct {
+type ListBroadcastGroupsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ok bool `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	Groups []*BroadcastGroup `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"` // oldest first
 }
 
-func (x *Ack) Reset() {
-	*x = Ack{}
+func (x *ListBroadcastGroupsResponse) Reset() {
+	*x = ListBroadcastGroupsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_blockterm_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1013,13 +1474,13 @@ func (x *Ack) Reset() {
 	}
 }
 
-func (x *Ack) String() string {
+func (x *ListBroadcastGroupsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Ack) ProtoMessage() {}
+func (*ListBroadcastGroupsResponse) ProtoMessage() {}
 
-func (x *Ack) ProtoReflect() protoreflect.Message {
+func (x *ListBroadcastGroupsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_blockterm_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1031,256 +1492,5634 @@ func (x *Ack) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Ack.ProtoReflect.Descriptor instead.
-func (*Ack) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListBroadcastGroupsResponse.ProtoReflect.Descriptor instead.
+func (*ListBroadcastGroupsResponse) Descriptor() ([]byte, []int) {
 	return file_blockterm_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *Ack) GetOk() bool {
+func (x *ListBroadcastGroupsResponse) GetGroups() []*BroadcastGroup {
 	if x != nil {
-		return x.Ok
+		return x.Groups
 	}
-	return false
+	return nil
 }
 
-var File_blockterm_proto protoreflect.FileDescriptor
+type GroupInputChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_blockterm_proto_rawDesc = []byte{
-	0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x12, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x1a, 0x1b, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d,
-	0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb0, 0x01, 0x0a, 0x13, 0x53, 0x74,
-	0x61, 0x72, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x65, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x73, 0x68, 0x65, 0x6c, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x77, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x77, 0x64, 0x12, 0x39, 0x0a, 0x03, 0x65, 0x6e, 0x76,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65,
-	0x72, 0x6d, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x03, 0x65, 0x6e, 0x76, 0x1a, 0x36, 0x0a, 0x08, 0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x35, 0x0a, 0x14,
-	0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x49, 0x64, 0x22, 0x34, 0x0a, 0x13, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x3f, 0x0a, 0x0a, 0x49, 0x6e, 0x70,
-	0x75, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x35, 0x0a, 0x14, 0x52, 0x65,
-	0x63, 0x65, 0x69, 0x76, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
-	0x64, 0x22, 0x40, 0x0a, 0x0b, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b,
-	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x22, 0x5d, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x53, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
-	0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x63, 0x6f, 0x6c, 0x73, 0x12, 0x12,
-	0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x6f,
-	0x77, 0x73, 0x22, 0x6b, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e,
-	0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74,
-	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x5f, 0x70, 0x6f, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x50, 0x6f, 0x73, 0x22,
-	0x4e, 0x0a, 0x0a, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
-	0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f,
-	0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22,
-	0x51, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0b, 0x73, 0x75, 0x67,
-	0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
-	0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65,
-	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x22, 0x9c, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x6d,
-	0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
-	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d,
-	0x6d, 0x61, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x77, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x63, 0x77, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63,
-	0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43,
-	0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x22, 0x41, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x22, 0x51, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x69, 0x73,
-	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x07,
-	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
-	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07,
-	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x34, 0x0a, 0x11, 0x53, 0x61, 0x76, 0x65, 0x4c,
-	0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
-	0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0a, 0x6a, 0x73, 0x6f, 0x6e, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x22, 0x35, 0x0a,
-	0x12, 0x4c, 0x6f, 0x61, 0x64, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x6c, 0x61, 0x79, 0x6f,
-	0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6a, 0x73, 0x6f, 0x6e, 0x4c, 0x61,
-	0x79, 0x6f, 0x75, 0x74, 0x22, 0x27, 0x0a, 0x0b, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x28, 0x0a,
-	0x0c, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x41, 0x0a, 0x0f, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x22, 0x15, 0x0a, 0x03, 0x41, 0x63,
-	0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x6f,
-	0x6b, 0x32, 0xe6, 0x02, 0x0a, 0x0f, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4f, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72,
-	0x6d, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72,
-	0x6d, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0c, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x53,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65,
-	0x72, 0x6d, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65,
-	0x72, 0x6d, 0x2e, 0x41, 0x63, 0x6b, 0x12, 0x34, 0x0a, 0x09, 0x53, 0x65, 0x6e, 0x64, 0x49, 0x6e,
-	0x70, 0x75, 0x74, 0x12, 0x15, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e,
-	0x49, 0x6e, 0x70, 0x75, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x1a, 0x0e, 0x2e, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x41, 0x63, 0x6b, 0x28, 0x01, 0x12, 0x4a, 0x0a, 0x0d,
-	0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1f, 0x2e,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76,
-	0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
-	0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x30, 0x01, 0x12, 0x40, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x69,
-	0x7a, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x53, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x41, 0x63, 0x6b, 0x32, 0x6a, 0x0a, 0x11, 0x53, 0x75,
-	0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x55, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x20, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x47, 0x65,
-	0x74, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e,
-	0x47, 0x65, 0x74, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xa3, 0x01, 0x0a, 0x0e, 0x48, 0x69, 0x73, 0x74, 0x6f,
-	0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x40, 0x0a, 0x0d, 0x52, 0x65, 0x63,
-	0x6f, 0x72, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x1f, 0x2e, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x6d,
-	0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x41, 0x63, 0x6b, 0x12, 0x4f, 0x0a, 0x0c, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1e, 0x2e, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x69, 0x73,
-	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x69, 0x73,
-	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x93, 0x01, 0x0a,
-	0x10, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x53, 0x61, 0x76, 0x65, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12,
-	0x1c, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x53, 0x61, 0x76, 0x65,
-	0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x41, 0x63, 0x6b, 0x12, 0x43, 0x0a,
-	0x0a, 0x4c, 0x6f, 0x61, 0x64, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x1a, 0x1d, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e,
-	0x4c, 0x6f, 0x61, 0x64, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x32, 0x8a, 0x01, 0x0a, 0x0d, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x2e, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d,
-	0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a,
-	0x0a, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
-	0x1b, 0x5a, 0x19, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x6e,
-	0x74, 0x6c, 0x2f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x65, 0x72, 0x6d, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	GroupId    string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Data       []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"` // raw terminal input
+	Seq        uint64 `protobuf:"varint,3,opt,name=seq,proto3" json:"seq,omitempty"`  // echoed in the result
+	GroupToken string `protobuf:"bytes,4,opt,name=group_token,json=groupToken,proto3" json:"group_token,omitempty"`
 }
 
-var (
-	file_blockterm_proto_rawDescOnce sync.Once
-	file_blockterm_proto_rawDescData = file_blockterm_proto_rawDesc
-)
+func (x *GroupInputChunk) Reset() {
+	*x = GroupInputChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_blockterm_proto_rawDescGZIP() []byte {
-	file_blockterm_proto_rawDescOnce.Do(func() {
-		file_blockterm_proto_rawDescData = protoimpl.X.CompressGZIP(file_blockterm_proto_rawDescData)
-	})
-	return file_blockterm_proto_rawDescData
+func (x *GroupInputChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_blockterm_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
-var file_blockterm_proto_goTypes = []any{
-	(*StartSessionRequest)(nil),    // 0: blockterm.StartSessionRequest
-	(*StartSessionResponse)(nil),   // 1: blockterm.StartSessionResponse
-	(*CloseSessionRequest)(nil),    // 2: blockterm.CloseSessionRequest
-	(*InputChunk)(nil),             // 3: blockterm.InputChunk
-	(*ReceiveOutputRequest)(nil),   // 4: blockterm.ReceiveOutputRequest
-	(*OutputChunk)(nil),            // 5: blockterm.OutputChunk
-	(*ResizeSessionRequest)(nil),   // 6: blockterm.ResizeSessionRequest
-	(*GetSuggestionsRequest)(nil),  // 7: blockterm.GetSuggestionsRequest
-	(*Suggestion)(nil),             // 8: blockterm.Suggestion
-	(*GetSuggestionsResponse)(nil), // 9: blockterm.GetSuggestionsResponse
-	(*RecordCommandRequest)(nil),   // 10: blockterm.RecordCommandRequest
-	(*QueryHistoryRequest)(nil),    // 11: blockterm.QueryHistoryRequest
-	(*QueryHistoryResponse)(nil),   // 12: blockterm.QueryHistoryResponse
-	(*SaveLayoutRequest)(nil),      // 13: blockterm.SaveLayoutRequest
-	(*LoadLayoutResponse)(nil),     // 14: blockterm.LoadLayoutResponse
-	(*PingRequest)(nil),            // 15: blockterm.PingRequest
-	(*PingResponse)(nil),           // 16: blockterm.PingResponse
-	(*VersionResponse)(nil),        // 17: blockterm.VersionResponse
-	(*Ack)(nil),                    // 18: blockterm.Ack
-	nil,                            // 19: blockterm.StartSessionRequest.EnvEntry
-	(*emptypb.Empty)(nil),          // 20: google.protobuf.Empty
+func (*GroupInputChunk) ProtoMessage() {}
+
+func (x *GroupInputChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_blockterm_proto_depIdxs = []int32{
-	19, // 0: blockterm.StartSessionRequest.env:type_name -> blockterm.StartSessionRequest.EnvEntry
-	8,  // 1: blockterm.GetSuggestionsResponse.suggestions:type_name -> blockterm.Suggestion
-	10, // 2: blockterm.QueryHistoryResponse.entries:type_name -> blockterm.RecordCommandRequest
-	0,  // 3: blockterm.TerminalService.StartSession:input_type -> blockterm.StartSessionRequest
-	2,  // 4: blockterm.TerminalService.CloseSession:input_type -> blockterm.CloseSessionRequest
-	3,  // 5: blockterm.TerminalService.SendInput:input_type -> blockterm.InputChunk
-	4,  // 6: blockterm.TerminalService.ReceiveOutput:input_type -> blockterm.ReceiveOutputRequest
-	6,  // 7: blockterm.TerminalService.ResizeSession:input_type -> blockterm.ResizeSessionRequest
-	7,  // 8: blockterm.SuggestionService.GetSuggestions:input_type -> blockterm.GetSuggestionsRequest
-	10, // 9: blockterm.HistoryService.RecordCommand:input_type -> blockterm.RecordCommandRequest
-	11, // 10: blockterm.HistoryService.QueryHistory:input_type -> blockterm.QueryHistoryRequest
-	13, // 11: blockterm.WorkspaceService.SaveLayout:input_type -> blockterm.SaveLayoutRequest
-	20, // 12: blockterm.WorkspaceService.LoadLayout:input_type -> google.protobuf.Empty
-	15, // 13: blockterm.SystemService.Ping:input_type -> blockterm.PingRequest
-	20, // 14: blockterm.SystemService.GetVersion:input_type -> google.protobuf.Empty
-	1,  // 15: blockterm.TerminalService.StartSession:output_type -> blockterm.StartSessionResponse
-	18, // 16: blockterm.TerminalService.CloseSession:output_type -> blockterm.Ack
-	18, // 17: blockterm.TerminalService.SendInput:output_type -> blockterm.Ack
-	5,  // 18: blockterm.TerminalService.ReceiveOutput:output_type -> blockterm.OutputChunk
-	18, // 19: blockterm.TerminalService.ResizeSession:output_type -> blockterm.Ack
-	9,  // 20: blockterm.SuggestionService.GetSuggestions:output_type -> blockterm.GetSuggestionsResponse
-	18, // 21: blockterm.HistoryService.RecordCommand:output_type -> blockterm.Ack
-	12, // 22: blockterm.HistoryService.QueryHistory:output_type -> blockterm.QueryHistoryResponse
-	18, // 23: blockterm.WorkspaceService.SaveLayout:output_type -> blockterm.Ack
-	14, // 24: blockterm.WorkspaceService.LoadLayout:output_type -> blockterm.LoadLayoutResponse
-	16, // 25: blockterm.SystemService.Ping:output_type -> blockterm.PingResponse
-	17, // 26: blockterm.SystemService.GetVersion:output_type -> blockterm.VersionResponse
-	15, // [15:27] is the sub-list for method output_type
-	3,  // [3:15] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+
+// Deprecated: Use GroupInputChunk.ProtoReflect.Descriptor instead.
+func (*GroupInputChunk) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{19}
 }
 
-func init() { file_blockterm_proto_init() }
-func file_blockterm_proto_init() {
-	if File_blockterm_proto != nil {
-		return
+func (x *GroupInputChunk) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_blockterm_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*StartSessionRequest); i {
+	return ""
+}
+
+func (x *GroupInputChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GroupInputChunk) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *GroupInputChunk) GetGroupToken() string {
+	if x != nil {
+		return x.GroupToken
+	}
+	return ""
+}
+
+type GroupInputResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupId   string           `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Seq       uint64           `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	Delivered uint32           `protobuf:"varint,3,opt,name=delivered,proto3" json:"delivered,omitempty"` // members the input was queued for
+	Failures  []*MemberFailure `protobuf:"bytes,4,rep,name=failures,proto3" json:"failures,omitempty"`
+	Error     string           `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"` // set if the group does not exist or group_token is wrong
+}
+
+func (x *GroupInputResult) Reset() {
+	*x = GroupInputResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupInputResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupInputResult) ProtoMessage() {}
+
+func (x *GroupInputResult) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupInputResult.ProtoReflect.Descriptor instead.
+func (*GroupInputResult) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GroupInputResult) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GroupInputResult) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *GroupInputResult) GetDelivered() uint32 {
+	if x != nil {
+		return x.Delivered
+	}
+	return 0
+}
+
+func (x *GroupInputResult) GetFailures() []*MemberFailure {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+func (x *GroupInputResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type MemberFailure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Error     string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *MemberFailure) Reset() {
+	*x = MemberFailure{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemberFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberFailure) ProtoMessage() {}
+
+func (x *MemberFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberFailure.ProtoReflect.Descriptor instead.
+func (*MemberFailure) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *MemberFailure) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *MemberFailure) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type RunCommandRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Command        string            `protobuf:"bytes,1,opt,name=command,proto3" json:"command,omitempty"` // command line, as typed at a prompt
+	Cwd            string            `protobuf:"bytes,2,opt,name=cwd,proto3" json:"cwd,omitempty"`
+	Env            map[string]string `protobuf:"bytes,3,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ProfileId      string            `protobuf:"bytes,4,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"` // shell, args, cwd, env and startup commands from a profile
+	Shell          string            `protobuf:"bytes,5,opt,name=shell,proto3" json:"shell,omitempty"`
+	Args           []string          `protobuf:"bytes,6,rep,name=args,proto3" json:"args,omitempty"` // extra shell arguments
+	Login          bool              `protobuf:"varint,7,opt,name=login,proto3" json:"login,omitempty"`
+	TimeoutMs      uint32            `protobuf:"varint,8,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`                  // kill the command and everything it started after this (0 = 60s)
+	MaxOutputBytes uint32            `protobuf:"varint,9,opt,name=max_output_bytes,json=maxOutputBytes,proto3" json:"max_output_bytes,omitempty"` // kept of each of stdout and stderr (0 = 1 MiB, at most 16 MiB)
+}
+
+func (x *RunCommandRequest) Reset() {
+	*x = RunCommandRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunCommandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunCommandRequest) ProtoMessage() {}
+
+func (x *RunCommandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunCommandRequest.ProtoReflect.Descriptor instead.
+func (*RunCommandRequest) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RunCommandRequest) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *RunCommandRequest) GetCwd() string {
+	if x != nil {
+		return x.Cwd
+	}
+	return ""
+}
+
+func (x *RunCommandRequest) GetEnv() map[string]string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *RunCommandRequest) GetProfileId() string {
+	if x != nil {
+		return x.ProfileId
+	}
+	return ""
+}
+
+func (x *RunCommandRequest) GetShell() string {
+	if x != nil {
+		return x.Shell
+	}
+	return ""
+}
+
+func (x *RunCommandRequest) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *RunCommandRequest) GetLogin() bool {
+	if x != nil {
+		return x.Login
+	}
+	return false
+}
+
+func (x *RunCommandRequest) GetTimeoutMs() uint32 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+func (x *RunCommandRequest) GetMaxOutputBytes() uint32 {
+	if x != nil {
+		return x.MaxOutputBytes
+	}
+	return 0
+}
+
+type RunCommandResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExitCode        int32  `protobuf:"varint,1,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"` // 128+n when killed by signal n
+	Signal          string `protobuf:"bytes,2,opt,name=signal,proto3" json:"signal,omitempty"`
+	TimedOut        bool   `protobuf:"varint,3,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"`
+	DurationMs      int64  `protobuf:"varint,4,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	Stdout          []byte `protobuf:"bytes,5,opt,name=stdout,proto3" json:"stdout,omitempty"` // empty for StreamCommand
+	Stderr          []byte `protobuf:"bytes,6,opt,name=stderr,proto3" json:"stderr,omitempty"`
+	StdoutTruncated bool   `protobuf:"varint,7,opt,name=stdout_truncated,json=stdoutTruncated,proto3" json:"stdout_truncated,omitempty"`
+	StderrTruncated bool   `protobuf:"varint,8,opt,name=stderr_truncated,json=stderrTruncated,proto3" json:"stderr_truncated,omitempty"`
+}
+
+func (x *RunCommandResult) Reset() {
+	*x = RunCommandResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunCommandResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunCommandResult) ProtoMessage() {}
+
+func (x *RunCommandResult) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunCommandResult.ProtoReflect.Descriptor instead.
+func (*RunCommandResult) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RunCommandResult) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *RunCommandResult) GetSignal() string {
+	if x != nil {
+		return x.Signal
+	}
+	return ""
+}
+
+func (x *RunCommandResult) GetTimedOut() bool {
+	if x != nil {
+		return x.TimedOut
+	}
+	return false
+}
+
+func (x *RunCommandResult) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *RunCommandResult) GetStdout() []byte {
+	if x != nil {
+		return x.Stdout
+	}
+	return nil
+}
+
+func (x *RunCommandResult) GetStderr() []byte {
+	if x != nil {
+		return x.Stderr
+	}
+	return nil
+}
+
+func (x *RunCommandResult) GetStdoutTruncated() bool {
+	if x != nil {
+		return x.StdoutTruncated
+	}
+	return false
+}
+
+func (x *RunCommandResult) GetStderrTruncated() bool {
+	if x != nil {
+		return x.StderrTruncated
+	}
+	return false
+}
+
+type RunCommandEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Event:
+	//	*RunCommandEvent_Output
+	//	*RunCommandEvent_Result
+	Event isRunCommandEvent_Event `protobuf_oneof:"event"`
+}
+
+func (x *RunCommandEvent) Reset() {
+	*x = RunCommandEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunCommandEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunCommandEvent) ProtoMessage() {}
+
+func (x *RunCommandEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunCommandEvent.ProtoReflect.Descriptor instead.
+func (*RunCommandEvent) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{24}
+}
+
+func (m *RunCommandEvent) GetEvent() isRunCommandEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *RunCommandEvent) GetOutput() *RunCommandOutput {
+	if x, ok := x.GetEvent().(*RunCommandEvent_Output); ok {
+		return x.Output
+	}
+	return nil
+}
+
+func (x *RunCommandEvent) GetResult() *RunCommandResult {
+	if x, ok := x.GetEvent().(*RunCommandEvent_Result); ok {
+		return x.Result
+	}
+	return nil
+}
+
+type isRunCommandEvent_Event interface {
+	isRunCommandEvent_Event()
+}
+
+type RunCommandEvent_Output struct {
+	Output *RunCommandOutput `protobuf:"bytes,1,opt,name=output,proto3,oneof"`
+}
+
+type RunCommandEvent_Result struct {
+	Result *RunCommandResult `protobuf:"bytes,2,opt,name=result,proto3,oneof"` // last
+}
+
+func (*RunCommandEvent_Output) isRunCommandEvent_Event() {}
+
+func (*RunCommandEvent_Result) isRunCommandEvent_Event() {}
+
+type RunCommandOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stream string `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"` // "stdout" or "stderr"
+	Data   []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *RunCommandOutput) Reset() {
+	*x = RunCommandOutput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunCommandOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunCommandOutput) ProtoMessage() {}
+
+func (x *RunCommandOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunCommandOutput.ProtoReflect.Descriptor instead.
+func (*RunCommandOutput) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RunCommandOutput) GetStream() string {
+	if x != nil {
+		return x.Stream
+	}
+	return ""
+}
+
+func (x *RunCommandOutput) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type SessionEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId string      `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Kind      string      `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`          // "idle_warning", "lifetime_warning", "reaped" or "trigger"
+	Reason    string      `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`      // "idle" or "lifetime"
+	Deadline  int64       `protobuf:"varint,4,opt,name=deadline,proto3" json:"deadline,omitempty"` // unix ms the session will be, or was, closed
+	Trigger   *TriggerHit `protobuf:"bytes,5,opt,name=trigger,proto3" json:"trigger,omitempty"`    // for "trigger"
+}
+
+func (x *SessionEvent) Reset() {
+	*x = SessionEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionEvent) ProtoMessage() {}
+
+func (x *SessionEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionEvent.ProtoReflect.Descriptor instead.
+func (*SessionEvent) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SessionEvent) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SessionEvent) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *SessionEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SessionEvent) GetDeadline() int64 {
+	if x != nil {
+		return x.Deadline
+	}
+	return 0
+}
+
+func (x *SessionEvent) GetTrigger() *TriggerHit {
+	if x != nil {
+		return x.Trigger
+	}
+	return nil
+}
+
+type TriggerHit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TriggerId string `protobuf:"bytes,1,opt,name=trigger_id,json=triggerId,proto3" json:"trigger_id,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Action    string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`                        // the trigger's action, already taken
+	Argument  string `protobuf:"bytes,4,opt,name=argument,proto3" json:"argument,omitempty"`                    // e.g. the highlight style
+	Offset    uint64 `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`                       // output offset of the matched text
+	Length    uint32 `protobuf:"varint,6,opt,name=length,proto3" json:"length,omitempty"`                       // length of the matched text in bytes
+	Text      string `protobuf:"bytes,7,opt,name=text,proto3" json:"text,omitempty"`                            // the matched text
+	CommandId string `protobuf:"bytes,8,opt,name=command_id,json=commandId,proto3" json:"command_id,omitempty"` // block the output belongs to, if any
+}
+
+func (x *TriggerHit) Reset() {
+	*x = TriggerHit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TriggerHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerHit) ProtoMessage() {}
+
+func (x *TriggerHit) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerHit.ProtoReflect.Descriptor instead.
+func (*TriggerHit) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *TriggerHit) GetTriggerId() string {
+	if x != nil {
+		return x.TriggerId
+	}
+	return ""
+}
+
+func (x *TriggerHit) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TriggerHit) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *TriggerHit) GetArgument() string {
+	if x != nil {
+		return x.Argument
+	}
+	return ""
+}
+
+func (x *TriggerHit) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *TriggerHit) GetLength() uint32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *TriggerHit) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *TriggerHit) GetCommandId() string {
+	if x != nil {
+		return x.CommandId
+	}
+	return ""
+}
+
+type TerminalSize struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cols uint32 `protobuf:"varint,1,opt,name=cols,proto3" json:"cols,omitempty"`
+	Rows uint32 `protobuf:"varint,2,opt,name=rows,proto3" json:"rows,omitempty"`
+}
+
+func (x *TerminalSize) Reset() {
+	*x = TerminalSize{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TerminalSize) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminalSize) ProtoMessage() {}
+
+func (x *TerminalSize) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminalSize.ProtoReflect.Descriptor instead.
+func (*TerminalSize) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *TerminalSize) GetCols() uint32 {
+	if x != nil {
+		return x.Cols
+	}
+	return 0
+}
+
+func (x *TerminalSize) GetRows() uint32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+type SubscribeAllRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Add    []*ReceiveOutputRequest `protobuf:"bytes,1,rep,name=add,proto3" json:"add,omitempty"`       // sessions to start streaming, each optionally resuming from an offset
+	Remove []string                `protobuf:"bytes,2,rep,name=remove,proto3" json:"remove,omitempty"` // session IDs to stop streaming
+}
+
+func (x *SubscribeAllRequest) Reset() {
+	*x = SubscribeAllRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeAllRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeAllRequest) ProtoMessage() {}
+
+func (x *SubscribeAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeAllRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeAllRequest) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SubscribeAllRequest) GetAdd() []*ReceiveOutputRequest {
+	if x != nil {
+		return x.Add
+	}
+	return nil
+}
+
+func (x *SubscribeAllRequest) GetRemove() []string {
+	if x != nil {
+		return x.Remove
+	}
+	return nil
+}
+
+type SubscribeAllResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Frame:
+	//	*SubscribeAllResponse_Output
+	//	*SubscribeAllResponse_Ended
+	Frame isSubscribeAllResponse_Frame `protobuf_oneof:"frame"`
+}
+
+func (x *SubscribeAllResponse) Reset() {
+	*x = SubscribeAllResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeAllResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeAllResponse) ProtoMessage() {}
+
+func (x *SubscribeAllResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeAllResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeAllResponse) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{30}
+}
+
+func (m *SubscribeAllResponse) GetFrame() isSubscribeAllResponse_Frame {
+	if m != nil {
+		return m.Frame
+	}
+	return nil
+}
+
+func (x *SubscribeAllResponse) GetOutput() *OutputChunk {
+	if x, ok := x.GetFrame().(*SubscribeAllResponse_Output); ok {
+		return x.Output
+	}
+	return nil
+}
+
+func (x *SubscribeAllResponse) GetEnded() *SubscriptionEnded {
+	if x, ok := x.GetFrame().(*SubscribeAllResponse_Ended); ok {
+		return x.Ended
+	}
+	return nil
+}
+
+type isSubscribeAllResponse_Frame interface {
+	isSubscribeAllResponse_Frame()
+}
+
+type SubscribeAllResponse_Output struct {
+	Output *OutputChunk `protobuf:"bytes,1,opt,name=output,proto3,oneof"`
+}
+
+type SubscribeAllResponse_Ended struct {
+	Ended *SubscriptionEnded `protobuf:"bytes,2,opt,name=ended,proto3,oneof"`
+}
+
+func (*SubscribeAllResponse_Output) isSubscribeAllResponse_Frame() {}
+
+func (*SubscribeAllResponse_Ended) isSubscribeAllResponse_Frame() {}
+
+// Sent when a session stops being streamed for any reason other than the
+// client removing it.
+type SubscriptionEnded struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Reason    string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"` // "not_found", "invalid", "permission_denied", "revoked", "exited", "detached" or "overflow"
+	Message   string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *SubscriptionEnded) Reset() {
+	*x = SubscriptionEnded{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscriptionEnded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionEnded) ProtoMessage() {}
+
+func (x *SubscriptionEnded) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionEnded.ProtoReflect.Descriptor instead.
+func (*SubscriptionEnded) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SubscriptionEnded) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SubscriptionEnded) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SubscriptionEnded) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type AttachRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Frame:
+	//	*AttachRequest_Open
+	//	*AttachRequest_Input
+	//	*AttachRequest_Resize
+	//	*AttachRequest_Signal
+	//	*AttachRequest_Ack
+	Frame isAttachRequest_Frame `protobuf_oneof:"frame"`
+}
+
+func (x *AttachRequest) Reset() {
+	*x = AttachRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttachRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachRequest) ProtoMessage() {}
+
+func (x *AttachRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachRequest.ProtoReflect.Descriptor instead.
+func (*AttachRequest) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{32}
+}
+
+func (m *AttachRequest) GetFrame() isAttachRequest_Frame {
+	if m != nil {
+		return m.Frame
+	}
+	return nil
+}
+
+func (x *AttachRequest) GetOpen() *AttachOpen {
+	if x, ok := x.GetFrame().(*AttachRequest_Open); ok {
+		return x.Open
+	}
+	return nil
+}
+
+func (x *AttachRequest) GetInput() []byte {
+	if x, ok := x.GetFrame().(*AttachRequest_Input); ok {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *AttachRequest) GetResize() *TerminalSize {
+	if x, ok := x.GetFrame().(*AttachRequest_Resize); ok {
+		return x.Resize
+	}
+	return nil
+}
+
+func (x *AttachRequest) GetSignal() string {
+	if x, ok := x.GetFrame().(*AttachRequest_Signal); ok {
+		return x.Signal
+	}
+	return ""
+}
+
+func (x *AttachRequest) GetAck() uint64 {
+	if x, ok := x.GetFrame().(*AttachRequest_Ack); ok {
+		return x.Ack
+	}
+	return 0
+}
+
+type isAttachRequest_Frame interface {
+	isAttachRequest_Frame()
+}
+
+type AttachRequest_Open struct {
+	Open *AttachOpen `protobuf:"bytes,1,opt,name=open,proto3,oneof"`
+}
+
+type AttachRequest_Input struct {
+	Input []byte `protobuf:"bytes,2,opt,name=input,proto3,oneof"`
+}
+
+type AttachRequest_Resize struct {
+	Resize *TerminalSize `protobuf:"bytes,3,opt,name=resize,proto3,oneof"`
+}
+
+type AttachRequest_Signal struct {
+	Signal string `protobuf:"bytes,4,opt,name=signal,proto3,oneof"` // e.g. "INT", "SIGTSTP"
+}
+
+type AttachRequest_Ack struct {
+	Ack uint64 `protobuf:"varint,5,opt,name=ack,proto3,oneof"` // grants this many more bytes of output credit
+}
+
+func (*AttachRequest_Open) isAttachRequest_Frame() {}
+
+func (*AttachRequest_Input) isAttachRequest_Frame() {}
+
+func (*AttachRequest_Resize) isAttachRequest_Frame() {}
+
+func (*AttachRequest_Signal) isAttachRequest_Frame() {}
+
+func (*AttachRequest_Ack) isAttachRequest_Frame() {}
+
+type AttachOpen struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId      string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	FromOffset     uint64 `protobuf:"varint,2,opt,name=from_offset,json=fromOffset,proto3" json:"from_offset,omitempty"`            // as in ReceiveOutputRequest
+	OverflowPolicy string `protobuf:"bytes,3,opt,name=overflow_policy,json=overflowPolicy,proto3" json:"overflow_policy,omitempty"` // as in ReceiveOutputRequest
+	Credits        uint64 `protobuf:"varint,4,opt,name=credits,proto3" json:"credits,omitempty"`                                    // initial output credit in bytes (0 = no flow control)
+	ShareToken     string `protobuf:"bytes,5,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"`             // owner_token or a share token; frames use its role
+	AfterSeq       uint64 `protobuf:"varint,6,opt,name=after_seq,json=afterSeq,proto3" json:"after_seq,omitempty"`                  // as in ReceiveOutputRequest
+}
+
+func (x *AttachOpen) Reset() {
+	*x = AttachOpen{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttachOpen) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachOpen) ProtoMessage() {}
+
+func (x *AttachOpen) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachOpen.ProtoReflect.Descriptor instead.
+func (*AttachOpen) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AttachOpen) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *AttachOpen) GetFromOffset() uint64 {
+	if x != nil {
+		return x.FromOffset
+	}
+	return 0
+}
+
+func (x *AttachOpen) GetOverflowPolicy() string {
+	if x != nil {
+		return x.OverflowPolicy
+	}
+	return ""
+}
+
+func (x *AttachOpen) GetCredits() uint64 {
+	if x != nil {
+		return x.Credits
+	}
+	return 0
+}
+
+func (x *AttachOpen) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+func (x *AttachOpen) GetAfterSeq() uint64 {
+	if x != nil {
+		return x.AfterSeq
+	}
+	return 0
+}
+
+type AttachResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Frame:
+	//	*AttachResponse_Output
+	//	*AttachResponse_Signaled
+	//	*AttachResponse_Error
+	Frame isAttachResponse_Frame `protobuf_oneof:"frame"`
+}
+
+func (x *AttachResponse) Reset() {
+	*x = AttachResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttachResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachResponse) ProtoMessage() {}
+
+func (x *AttachResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachResponse.ProtoReflect.Descriptor instead.
+func (*AttachResponse) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{34}
+}
+
+func (m *AttachResponse) GetFrame() isAttachResponse_Frame {
+	if m != nil {
+		return m.Frame
+	}
+	return nil
+}
+
+func (x *AttachResponse) GetOutput() *OutputChunk {
+	if x, ok := x.GetFrame().(*AttachResponse_Output); ok {
+		return x.Output
+	}
+	return nil
+}
+
+func (x *AttachResponse) GetSignaled() *SignalSessionResponse {
+	if x, ok := x.GetFrame().(*AttachResponse_Signaled); ok {
+		return x.Signaled
+	}
+	return nil
+}
+
+func (x *AttachResponse) GetError() *AttachError {
+	if x, ok := x.GetFrame().(*AttachResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isAttachResponse_Frame interface {
+	isAttachResponse_Frame()
+}
+
+type AttachResponse_Output struct {
+	Output *OutputChunk `protobuf:"bytes,1,opt,name=output,proto3,oneof"` // output, block metadata, state and resize frames, in order
+}
+
+type AttachResponse_Signaled struct {
+	Signaled *SignalSessionResponse `protobuf:"bytes,2,opt,name=signaled,proto3,oneof"`
+}
+
+type AttachResponse_Error struct {
+	Error *AttachError `protobuf:"bytes,3,opt,name=error,proto3,oneof"` // a client frame failed; the stream stays open
+}
+
+func (*AttachResponse_Output) isAttachResponse_Frame() {}
+
+func (*AttachResponse_Signaled) isAttachResponse_Frame() {}
+
+func (*AttachResponse_Error) isAttachResponse_Frame() {}
+
+type AttachError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Frame   string `protobuf:"bytes,1,opt,name=frame,proto3" json:"frame,omitempty"` // "input", "resize" or "signal"
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *AttachError) Reset() {
+	*x = AttachError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttachError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachError) ProtoMessage() {}
+
+func (x *AttachError) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachError.ProtoReflect.Descriptor instead.
+func (*AttachError) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *AttachError) GetFrame() string {
+	if x != nil {
+		return x.Frame
+	}
+	return ""
+}
+
+func (x *AttachError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ProcessInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pid  int32    `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	Name string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Argv []string `protobuf:"bytes,3,rep,name=argv,proto3" json:"argv,omitempty"` // empty if it could not be read
+}
+
+func (x *ProcessInfo) Reset() {
+	*x = ProcessInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessInfo) ProtoMessage() {}
+
+func (x *ProcessInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessInfo.ProtoReflect.Descriptor instead.
+func (*ProcessInfo) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ProcessInfo) GetPid() int32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *ProcessInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProcessInfo) GetArgv() []string {
+	if x != nil {
+		return x.Argv
+	}
+	return nil
+}
+
+type SessionStateChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Foreground *ProcessInfo `protobuf:"bytes,1,opt,name=foreground,proto3" json:"foreground,omitempty"`                 // leader of the terminal's foreground process group (the shell at a prompt)
+	AltScreen  bool         `protobuf:"varint,2,opt,name=alt_screen,json=altScreen,proto3" json:"alt_screen,omitempty"` // a full-screen program switched to the alternate screen
+}
+
+func (x *SessionStateChange) Reset() {
+	*x = SessionStateChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionStateChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionStateChange) ProtoMessage() {}
+
+func (x *SessionStateChange) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionStateChange.ProtoReflect.Descriptor instead.
+func (*SessionStateChange) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SessionStateChange) GetForeground() *ProcessInfo {
+	if x != nil {
+		return x.Foreground
+	}
+	return nil
+}
+
+func (x *SessionStateChange) GetAltScreen() bool {
+	if x != nil {
+		return x.AltScreen
+	}
+	return false
+}
+
+type ResizeSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId  string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Cols       uint32 `protobuf:"varint,2,opt,name=cols,proto3" json:"cols,omitempty"`
+	Rows       uint32 `protobuf:"varint,3,opt,name=rows,proto3" json:"rows,omitempty"`
+	ShareToken string `protobuf:"bytes,4,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"` // owner_token or a share token
+}
+
+func (x *ResizeSessionRequest) Reset() {
+	*x = ResizeSessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResizeSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResizeSessionRequest) ProtoMessage() {}
+
+func (x *ResizeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResizeSessionRequest.ProtoReflect.Descriptor instead.
+func (*ResizeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ResizeSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ResizeSessionRequest) GetCols() uint32 {
+	if x != nil {
+		return x.Cols
+	}
+	return 0
+}
+
+func (x *ResizeSessionRequest) GetRows() uint32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *ResizeSessionRequest) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+type GetSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId  string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	ShareToken string `protobuf:"bytes,2,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"` // owner_token or a share token
+}
+
+func (x *GetSessionRequest) Reset() {
+	*x = GetSessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionRequest) ProtoMessage() {}
+
+func (x *GetSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSessionRequest.ProtoReflect.Descriptor instead.
+func (*GetSessionRequest) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *GetSessionRequest) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+type DetachSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId  string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	ShareToken string `protobuf:"bytes,2,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"` // the owner_token
+}
+
+func (x *DetachSessionRequest) Reset() {
+	*x = DetachSessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DetachSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetachSessionRequest) ProtoMessage() {}
+
+func (x *DetachSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetachSessionRequest.ProtoReflect.Descriptor instead.
+func (*DetachSessionRequest) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DetachSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *DetachSessionRequest) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+type SessionInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId        string          `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Shell            string          `protobuf:"bytes,2,opt,name=shell,proto3" json:"shell,omitempty"`
+	Cwd              string          `protobuf:"bytes,3,opt,name=cwd,proto3" json:"cwd,omitempty"`
+	Cols             uint32          `protobuf:"varint,4,opt,name=cols,proto3" json:"cols,omitempty"`
+	Rows             uint32          `protobuf:"varint,5,opt,name=rows,proto3" json:"rows,omitempty"`
+	State            string          `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`                                                 // "running", "closed", "exited"
+	CreatedAt        int64           `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                       // unix seconds
+	CurrentCommandId string          `protobuf:"bytes,8,opt,name=current_command_id,json=currentCommandId,proto3" json:"current_command_id,omitempty"` // last command block seen on the output
+	CommandStatus    string          `protobuf:"bytes,9,opt,name=command_status,json=commandStatus,proto3" json:"command_status,omitempty"`            // "running", "completed", "failed"
+	CommandExitCode  int32           `protobuf:"varint,10,opt,name=command_exit_code,json=commandExitCode,proto3" json:"command_exit_code,omitempty"`  // exit code of the last completed command
+	AttachedClients  uint32          `protobuf:"varint,11,opt,name=attached_clients,json=attachedClients,proto3" json:"attached_clients,omitempty"`    // number of open output streams
+	DetachedAt       int64           `protobuf:"varint,12,opt,name=detached_at,json=detachedAt,proto3" json:"detached_at,omitempty"`                   // unix seconds since no client is attached (0 while attached)
+	CurrentCommand   string          `protobuf:"bytes,13,opt,name=current_command,json=currentCommand,proto3" json:"current_command,omitempty"`        // command line of the current/last command block
+	Foreground       *ProcessInfo    `protobuf:"bytes,14,opt,name=foreground,proto3" json:"foreground,omitempty"`                                      // leader of the terminal's foreground process group
+	AltScreen        bool            `protobuf:"varint,15,opt,name=alt_screen,json=altScreen,proto3" json:"alt_screen,omitempty"`                      // alternate screen active (vim, less, htop, ...)
+	RecordingId      string          `protobuf:"bytes,16,opt,name=recording_id,json=recordingId,proto3" json:"recording_id,omitempty"`                 // active recording ("" when not recording)
+	Shares           []*SessionShare `protobuf:"bytes,17,rep,name=shares,proto3" json:"shares,omitempty"`                                              // share tokens handed out, oldest first
+	OutputOffset     uint64          `protobuf:"varint,18,opt,name=output_offset,json=outputOffset,proto3" json:"output_offset,omitempty"`             // end of the output stream so far; resume or wait from here
+	OwnerToken       string          `protobuf:"bytes,19,opt,name=owner_token,json=ownerToken,proto3" json:"owner_token,omitempty"`                    // only from ListSessions, so a restarted client can take its sessions back
+}
+
+func (x *SessionInfo) Reset() {
+	*x = SessionInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionInfo) ProtoMessage() {}
+
+func (x *SessionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionInfo.ProtoReflect.Descriptor instead.
+func (*SessionInfo) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SessionInfo) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetShell() string {
+	if x != nil {
+		return x.Shell
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetCwd() string {
+	if x != nil {
+		return x.Cwd
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetCols() uint32 {
+	if x != nil {
+		return x.Cols
+	}
+	return 0
+}
+
+func (x *SessionInfo) GetRows() uint32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *SessionInfo) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *SessionInfo) GetCurrentCommandId() string {
+	if x != nil {
+		return x.CurrentCommandId
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetCommandStatus() string {
+	if x != nil {
+		return x.CommandStatus
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetCommandExitCode() int32 {
+	if x != nil {
+		return x.CommandExitCode
+	}
+	return 0
+}
+
+func (x *SessionInfo) GetAttachedClients() uint32 {
+	if x != nil {
+		return x.AttachedClients
+	}
+	return 0
+}
+
+func (x *SessionInfo) GetDetachedAt() int64 {
+	if x != nil {
+		return x.DetachedAt
+	}
+	return 0
+}
+
+func (x *SessionInfo) GetCurrentCommand() string {
+	if x != nil {
+		return x.CurrentCommand
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetForeground() *ProcessInfo {
+	if x != nil {
+		return x.Foreground
+	}
+	return nil
+}
+
+func (x *SessionInfo) GetAltScreen() bool {
+	if x != nil {
+		return x.AltScreen
+	}
+	return false
+}
+
+func (x *SessionInfo) GetRecordingId() string {
+	if x != nil {
+		return x.RecordingId
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetShares() []*SessionShare {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+func (x *SessionInfo) GetOutputOffset() uint64 {
+	if x != nil {
+		return x.OutputOffset
+	}
+	return 0
+}
+
+func (x *SessionInfo) GetOwnerToken() string {
+	if x != nil {
+		return x.OwnerToken
+	}
+	return ""
+}
+
+type ListSessionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sessions []*SessionInfo `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+}
+
+func (x *ListSessionsResponse) Reset() {
+	*x = ListSessionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsResponse) ProtoMessage() {}
+
+func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListSessionsResponse) GetSessions() []*SessionInfo {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type GetCwdHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId  string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	ShareToken string `protobuf:"bytes,2,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"` // owner_token or a share token
+}
+
+func (x *GetCwdHistoryRequest) Reset() {
+	*x = GetCwdHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCwdHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCwdHistoryRequest) ProtoMessage() {}
+
+func (x *GetCwdHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCwdHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetCwdHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetCwdHistoryRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *GetCwdHistoryRequest) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+type CwdChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path      string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Timestamp int64  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // unix seconds
+}
+
+func (x *CwdChange) Reset() {
+	*x = CwdChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CwdChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CwdChange) ProtoMessage() {}
+
+func (x *CwdChange) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CwdChange.ProtoReflect.Descriptor instead.
+func (*CwdChange) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CwdChange) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *CwdChange) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type GetCwdHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*CwdChange `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"` // oldest first; last entry is the current cwd
+}
+
+func (x *GetCwdHistoryResponse) Reset() {
+	*x = GetCwdHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCwdHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCwdHistoryResponse) ProtoMessage() {}
+
+func (x *GetCwdHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCwdHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetCwdHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetCwdHistoryResponse) GetEntries() []*CwdChange {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type SetRecordingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId  string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Enabled    bool   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	ShareToken string `protobuf:"bytes,3,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"` // the owner_token
+}
+
+func (x *SetRecordingRequest) Reset() {
+	*x = SetRecordingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetRecordingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRecordingRequest) ProtoMessage() {}
+
+func (x *SetRecordingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRecordingRequest.ProtoReflect.Descriptor instead.
+func (*SetRecordingRequest) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SetRecordingRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SetRecordingRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SetRecordingRequest) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+type SetRecordingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecordingId string `protobuf:"bytes,1,opt,name=recording_id,json=recordingId,proto3" json:"recording_id,omitempty"` // recording started, or the one just stopped ("" if none)
+	Path        string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *SetRecordingResponse) Reset() {
+	*x = SetRecordingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetRecordingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRecordingResponse) ProtoMessage() {}
+
+func (x *SetRecordingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRecordingResponse.ProtoReflect.Descriptor instead.
+func (*SetRecordingResponse) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SetRecordingResponse) GetRecordingId() string {
+	if x != nil {
+		return x.RecordingId
+	}
+	return ""
+}
+
+func (x *SetRecordingResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type SignalSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId  string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Signal     string `protobuf:"bytes,2,opt,name=signal,proto3" json:"signal,omitempty"`                           // "INT", "TERM", "KILL", "TSTP", "CONT", "HUP", "QUIT" (optional "SIG" prefix)
+	ShareToken string `protobuf:"bytes,3,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"` // owner_token or a share token
+}
+
+func (x *SignalSessionRequest) Reset() {
+	*x = SignalSessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignalSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignalSessionRequest) ProtoMessage() {}
+
+func (x *SignalSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignalSessionRequest.ProtoReflect.Descriptor instead.
+func (*SignalSessionRequest) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SignalSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SignalSessionRequest) GetSignal() string {
+	if x != nil {
+		return x.Signal
+	}
+	return ""
+}
+
+func (x *SignalSessionRequest) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+type SignalSessionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pgid int32   `protobuf:"varint,1,opt,name=pgid,proto3" json:"pgid,omitempty"`        // foreground process group that was signaled
+	Pids []int32 `protobuf:"varint,2,rep,packed,name=pids,proto3" json:"pids,omitempty"` // members of the group at the time of the signal
+}
+
+func (x *SignalSessionResponse) Reset() {
+	*x = SignalSessionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignalSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignalSessionResponse) ProtoMessage() {}
+
+func (x *SignalSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignalSessionResponse.ProtoReflect.Descriptor instead.
+func (*SignalSessionResponse) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SignalSessionResponse) GetPgid() int32 {
+	if x != nil {
+		return x.Pgid
+	}
+	return 0
+}
+
+func (x *SignalSessionResponse) GetPids() []int32 {
+	if x != nil {
+		return x.Pids
+	}
+	return nil
+}
+
+type GetSuggestionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Input     string `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
+	CursorPos uint32 `protobuf:"varint,3,opt,name=cursor_pos,json=cursorPos,proto3" json:"cursor_pos,omitempty"`
+}
+
+func (x *GetSuggestionsRequest) Reset() {
+	*x = GetSuggestionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSuggestionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSuggestionsRequest) ProtoMessage() {}
+
+func (x *GetSuggestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSuggestionsRequest.ProtoReflect.Descriptor instead.
+func (*GetSuggestionsRequest) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetSuggestionsRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *GetSuggestionsRequest) GetInput() string {
+	if x != nil {
+		return x.Input
+	}
+	return ""
+}
+
+func (x *GetSuggestionsRequest) GetCursorPos() uint32 {
+	if x != nil {
+		return x.CursorPos
+	}
+	return 0
+}
+
+type Suggestion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text   string  `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Source string  `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"` // "history", "filesystem", "static", "ai"
+	Score  float32 `protobuf:"fixed32,3,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *Suggestion) Reset() {
+	*x = Suggestion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Suggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Suggestion) ProtoMessage() {}
+
+func (x *Suggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Suggestion.ProtoReflect.Descriptor instead.
+func (*Suggestion) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *Suggestion) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Suggestion) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Suggestion) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type GetSuggestionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Suggestions []*Suggestion `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+}
+
+func (x *GetSuggestionsResponse) Reset() {
+	*x = GetSuggestionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSuggestionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSuggestionsResponse) ProtoMessage() {}
+
+func (x *GetSuggestionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSuggestionsResponse.ProtoReflect.Descriptor instead.
+func (*GetSuggestionsResponse) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetSuggestionsResponse) GetSuggestions() []*Suggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+type RecordCommandRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Command   string `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`
+	Cwd       string `protobuf:"bytes,3,opt,name=cwd,proto3" json:"cwd,omitempty"`
+	ExitCode  *int32 `protobuf:"varint,4,opt,name=exit_code,json=exitCode,proto3,oneof" json:"exit_code,omitempty"` // unset if unknown
+	Timestamp int64  `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`                     // unix seconds (0 = now)
+}
+
+func (x *RecordCommandRequest) Reset() {
+	*x = RecordCommandRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordCommandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordCommandRequest) ProtoMessage() {}
+
+func (x *RecordCommandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordCommandRequest.ProtoReflect.Descriptor instead.
+func (*RecordCommandRequest) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RecordCommandRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *RecordCommandRequest) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *RecordCommandRequest) GetCwd() string {
+	if x != nil {
+		return x.Cwd
+	}
+	return ""
+}
+
+func (x *RecordCommandRequest) GetExitCode() int32 {
+	if x != nil && x.ExitCode != nil {
+		return *x.ExitCode
+	}
+	return 0
+}
+
+func (x *RecordCommandRequest) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type QueryHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *QueryHistoryRequest) Reset() {
+	*x = QueryHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryHistoryRequest) ProtoMessage() {}
+
+func (x *QueryHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryHistoryRequest.ProtoReflect.Descriptor instead.
+func (*QueryHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *QueryHistoryRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *QueryHistoryRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type QueryHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*RecordCommandRequest `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *QueryHistoryResponse) Reset() {
+	*x = QueryHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryHistoryResponse) ProtoMessage() {}
+
+func (x *QueryHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryHistoryResponse.ProtoReflect.Descriptor instead.
+func (*QueryHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *QueryHistoryResponse) GetEntries() []*RecordCommandRequest {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type RecordingInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecordingId string `protobuf:"bytes,1,opt,name=recording_id,json=recordingId,proto3" json:"recording_id,omitempty"`
+	SessionId   string `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Path        string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	Title       string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	CreatedAt   int64  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`    // unix seconds the recording started
+	DurationMs  int64  `protobuf:"varint,6,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"` // time of the last event, relative to session start
+	SizeBytes   int64  `protobuf:"varint,7,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	Cols        uint32 `protobuf:"varint,8,opt,name=cols,proto3" json:"cols,omitempty"` // terminal size when recording started
+	Rows        uint32 `protobuf:"varint,9,opt,name=rows,proto3" json:"rows,omitempty"`
+	Active      bool   `protobuf:"varint,10,opt,name=active,proto3" json:"active,omitempty"` // still being written
+}
+
+func (x *RecordingInfo) Reset() {
+	*x = RecordingInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordingInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordingInfo) ProtoMessage() {}
+
+func (x *RecordingInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordingInfo.ProtoReflect.Descriptor instead.
+func (*RecordingInfo) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *RecordingInfo) GetRecordingId() string {
+	if x != nil {
+		return x.RecordingId
+	}
+	return ""
+}
+
+func (x *RecordingInfo) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *RecordingInfo) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *RecordingInfo) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *RecordingInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *RecordingInfo) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *RecordingInfo) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *RecordingInfo) GetCols() uint32 {
+	if x != nil {
+		return x.Cols
+	}
+	return 0
+}
+
+func (x *RecordingInfo) GetRows() uint32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *RecordingInfo) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type ListRecordingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Recordings []*RecordingInfo `protobuf:"bytes,1,rep,name=recordings,proto3" json:"recordings,omitempty"` // newest first
+}
+
+func (x *ListRecordingsResponse) Reset() {
+	*x = ListRecordingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRecordingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecordingsResponse) ProtoMessage() {}
+
+func (x *ListRecordingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecordingsResponse.ProtoReflect.Descriptor instead.
+func (*ListRecordingsResponse) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListRecordingsResponse) GetRecordings() []*RecordingInfo {
+	if x != nil {
+		return x.Recordings
+	}
+	return nil
+}
+
+type DeleteRecordingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecordingId string `protobuf:"bytes,1,opt,name=recording_id,json=recordingId,proto3" json:"recording_id,omitempty"`
+}
+
+func (x *DeleteRecordingRequest) Reset() {
+	*x = DeleteRecordingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteRecordingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRecordingRequest) ProtoMessage() {}
+
+func (x *DeleteRecordingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRecordingRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRecordingRequest) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *DeleteRecordingRequest) GetRecordingId() string {
+	if x != nil {
+		return x.RecordingId
+	}
+	return ""
+}
+
+type Profile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`       // assigned by CreateProfile
+	Name            string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`   // unique
+	Shell           string            `protobuf:"bytes,3,opt,name=shell,proto3" json:"shell,omitempty"` // empty for the default shell
+	Args            []string          `protobuf:"bytes,4,rep,name=args,proto3" json:"args,omitempty"`
+	Env             map[string]string `protobuf:"bytes,5,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Cwd             string            `protobuf:"bytes,6,opt,name=cwd,proto3" json:"cwd,omitempty"`
+	StartupCommands []string          `protobuf:"bytes,7,rep,name=startup_commands,json=startupCommands,proto3" json:"startup_commands,omitempty"` // typed into the shell once it has started
+	CreatedAt       int64             `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                  // unix seconds
+	UpdatedAt       int64             `protobuf:"varint,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *Profile) Reset() {
+	*x = Profile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Profile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Profile) ProtoMessage() {}
+
+func (x *Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Profile.ProtoReflect.Descriptor instead.
+func (*Profile) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *Profile) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Profile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Profile) GetShell() string {
+	if x != nil {
+		return x.Shell
+	}
+	return ""
+}
+
+func (x *Profile) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *Profile) GetEnv() map[string]string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *Profile) GetCwd() string {
+	if x != nil {
+		return x.Cwd
+	}
+	return ""
+}
+
+func (x *Profile) GetStartupCommands() []string {
+	if x != nil {
+		return x.StartupCommands
+	}
+	return nil
+}
+
+func (x *Profile) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Profile) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type Trigger struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // assigned by CreateTrigger
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Pattern string `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"`                // RE2 regex matched against each output line, without its line ending
+	Scope   string `protobuf:"bytes,4,opt,name=scope,proto3" json:"scope,omitempty"`                    // "all" (default), "profile" or "session"
+	ScopeId string `protobuf:"bytes,5,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"` // the profile or session ID for those scopes
+	// "event": report on WatchSessionEvents; "highlight": mark the match
+	// (argument names a style); "send_input": type argument into the session
+	// (scope "session" only); "fail_block": report the current block as
+	// failed; "bell": ring the bell.
+	Action     string `protobuf:"bytes,6,opt,name=action,proto3" json:"action,omitempty"`
+	Argument   string `protobuf:"bytes,7,opt,name=argument,proto3" json:"argument,omitempty"`
+	Enabled    bool   `protobuf:"varint,8,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	CreatedAt  int64  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // unix seconds
+	UpdatedAt  int64  `protobuf:"varint,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ShareToken string `protobuf:"bytes,11,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"` // owner_token of the scope_id session, for "send_input" and session-scoped "fail_block"; never returned
+}
+
+func (x *Trigger) Reset() {
+	*x = Trigger{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Trigger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Trigger) ProtoMessage() {}
+
+func (x *Trigger) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Trigger.ProtoReflect.Descriptor instead.
+func (*Trigger) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *Trigger) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Trigger) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Trigger) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *Trigger) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *Trigger) GetScopeId() string {
+	if x != nil {
+		return x.ScopeId
+	}
+	return ""
+}
+
+func (x *Trigger) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *Trigger) GetArgument() string {
+	if x != nil {
+		return x.Argument
+	}
+	return ""
+}
+
+func (x *Trigger) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Trigger) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Trigger) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Trigger) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+type ListTriggersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Triggers []*Trigger `protobuf:"bytes,1,rep,name=triggers,proto3" json:"triggers,omitempty"` // oldest first
+}
+
+func (x *ListTriggersResponse) Reset() {
+	*x = ListTriggersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTriggersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTriggersResponse) ProtoMessage() {}
+
+func (x *ListTriggersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTriggersResponse.ProtoReflect.Descriptor instead.
+func (*ListTriggersResponse) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListTriggersResponse) GetTriggers() []*Trigger {
+	if x != nil {
+		return x.Triggers
+	}
+	return nil
+}
+
+type GetTriggerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TriggerId string `protobuf:"bytes,1,opt,name=trigger_id,json=triggerId,proto3" json:"trigger_id,omitempty"`
+}
+
+func (x *GetTriggerRequest) Reset() {
+	*x = GetTriggerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTriggerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTriggerRequest) ProtoMessage() {}
+
+func (x *GetTriggerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTriggerRequest.ProtoReflect.Descriptor instead.
+func (*GetTriggerRequest) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetTriggerRequest) GetTriggerId() string {
+	if x != nil {
+		return x.TriggerId
+	}
+	return ""
+}
+
+type DeleteTriggerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TriggerId string `protobuf:"bytes,1,opt,name=trigger_id,json=triggerId,proto3" json:"trigger_id,omitempty"`
+}
+
+func (x *DeleteTriggerRequest) Reset() {
+	*x = DeleteTriggerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteTriggerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTriggerRequest) ProtoMessage() {}
+
+func (x *DeleteTriggerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTriggerRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTriggerRequest) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *DeleteTriggerRequest) GetTriggerId() string {
+	if x != nil {
+		return x.TriggerId
+	}
+	return ""
+}
+
+type ListProfilesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Profiles []*Profile `protobuf:"bytes,1,rep,name=profiles,proto3" json:"profiles,omitempty"` // by name
+}
+
+func (x *ListProfilesResponse) Reset() {
+	*x = ListProfilesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProfilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProfilesResponse) ProtoMessage() {}
+
+func (x *ListProfilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProfilesResponse.ProtoReflect.Descriptor instead.
+func (*ListProfilesResponse) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListProfilesResponse) GetProfiles() []*Profile {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
+type GetProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProfileId string `protobuf:"bytes,1,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+}
+
+func (x *GetProfileRequest) Reset() {
+	*x = GetProfileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileRequest) ProtoMessage() {}
+
+func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetProfileRequest) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetProfileRequest) GetProfileId() string {
+	if x != nil {
+		return x.ProfileId
+	}
+	return ""
+}
+
+type DeleteProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProfileId string `protobuf:"bytes,1,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+}
+
+func (x *DeleteProfileRequest) Reset() {
+	*x = DeleteProfileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProfileRequest) ProtoMessage() {}
+
+func (x *DeleteProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProfileRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProfileRequest) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *DeleteProfileRequest) GetProfileId() string {
+	if x != nil {
+		return x.ProfileId
+	}
+	return ""
+}
+
+type OpenPlaybackRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecordingId   string  `protobuf:"bytes,1,opt,name=recording_id,json=recordingId,proto3" json:"recording_id,omitempty"`           // a recording from RecordingService, or
+	Path          string  `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`                                            // any asciicast v2 file
+	Speed         float64 `protobuf:"fixed64,3,opt,name=speed,proto3" json:"speed,omitempty"`                                        // playback speed multiplier (0 = 1x)
+	IdleTimeLimit float64 `protobuf:"fixed64,4,opt,name=idle_time_limit,json=idleTimeLimit,proto3" json:"idle_time_limit,omitempty"` // cap pauses between events, in seconds (0 = the file's own limit, if any)
+	StartPaused   bool    `protobuf:"varint,5,opt,name=start_paused,json=startPaused,proto3" json:"start_paused,omitempty"`
+}
+
+func (x *OpenPlaybackRequest) Reset() {
+	*x = OpenPlaybackRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenPlaybackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenPlaybackRequest) ProtoMessage() {}
+
+func (x *OpenPlaybackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenPlaybackRequest.ProtoReflect.Descriptor instead.
+func (*OpenPlaybackRequest) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *OpenPlaybackRequest) GetRecordingId() string {
+	if x != nil {
+		return x.RecordingId
+	}
+	return ""
+}
+
+func (x *OpenPlaybackRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *OpenPlaybackRequest) GetSpeed() float64 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+func (x *OpenPlaybackRequest) GetIdleTimeLimit() float64 {
+	if x != nil {
+		return x.IdleTimeLimit
+	}
+	return 0
+}
+
+func (x *OpenPlaybackRequest) GetStartPaused() bool {
+	if x != nil {
+		return x.StartPaused
+	}
+	return false
+}
+
+type PlaybackInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlaybackId string  `protobuf:"bytes,1,opt,name=playback_id,json=playbackId,proto3" json:"playback_id,omitempty"` // also the session_id of the streamed chunks
+	Title      string  `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Cols       uint32  `protobuf:"varint,3,opt,name=cols,proto3" json:"cols,omitempty"`
+	Rows       uint32  `protobuf:"varint,4,opt,name=rows,proto3" json:"rows,omitempty"`
+	DurationMs int64   `protobuf:"varint,5,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"` // after idle-time capping
+	PositionMs int64   `protobuf:"varint,6,opt,name=position_ms,json=positionMs,proto3" json:"position_ms,omitempty"`
+	Speed      float64 `protobuf:"fixed64,7,opt,name=speed,proto3" json:"speed,omitempty"`
+	Paused     bool    `protobuf:"varint,8,opt,name=paused,proto3" json:"paused,omitempty"`
+}
+
+func (x *PlaybackInfo) Reset() {
+	*x = PlaybackInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlaybackInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaybackInfo) ProtoMessage() {}
+
+func (x *PlaybackInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaybackInfo.ProtoReflect.Descriptor instead.
+func (*PlaybackInfo) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *PlaybackInfo) GetPlaybackId() string {
+	if x != nil {
+		return x.PlaybackId
+	}
+	return ""
+}
+
+func (x *PlaybackInfo) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PlaybackInfo) GetCols() uint32 {
+	if x != nil {
+		return x.Cols
+	}
+	return 0
+}
+
+func (x *PlaybackInfo) GetRows() uint32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *PlaybackInfo) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *PlaybackInfo) GetPositionMs() int64 {
+	if x != nil {
+		return x.PositionMs
+	}
+	return 0
+}
+
+func (x *PlaybackInfo) GetSpeed() float64 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+func (x *PlaybackInfo) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+type StreamPlaybackRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlaybackId string `protobuf:"bytes,1,opt,name=playback_id,json=playbackId,proto3" json:"playback_id,omitempty"`
+}
+
+func (x *StreamPlaybackRequest) Reset() {
+	*x = StreamPlaybackRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamPlaybackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamPlaybackRequest) ProtoMessage() {}
+
+func (x *StreamPlaybackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamPlaybackRequest.ProtoReflect.Descriptor instead.
+func (*StreamPlaybackRequest) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *StreamPlaybackRequest) GetPlaybackId() string {
+	if x != nil {
+		return x.PlaybackId
+	}
+	return ""
+}
+
+type ControlPlaybackRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlaybackId string  `protobuf:"bytes,1,opt,name=playback_id,json=playbackId,proto3" json:"playback_id,omitempty"`
+	Action     string  `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`                            // "pause", "resume", "seek", "speed"
+	PositionMs int64   `protobuf:"varint,3,opt,name=position_ms,json=positionMs,proto3" json:"position_ms,omitempty"` // for "seek"
+	Speed      float64 `protobuf:"fixed64,4,opt,name=speed,proto3" json:"speed,omitempty"`                            // for "speed"
+}
+
+func (x *ControlPlaybackRequest) Reset() {
+	*x = ControlPlaybackRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ControlPlaybackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControlPlaybackRequest) ProtoMessage() {}
+
+func (x *ControlPlaybackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControlPlaybackRequest.ProtoReflect.Descriptor instead.
+func (*ControlPlaybackRequest) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ControlPlaybackRequest) GetPlaybackId() string {
+	if x != nil {
+		return x.PlaybackId
+	}
+	return ""
+}
+
+func (x *ControlPlaybackRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ControlPlaybackRequest) GetPositionMs() int64 {
+	if x != nil {
+		return x.PositionMs
+	}
+	return 0
+}
+
+func (x *ControlPlaybackRequest) GetSpeed() float64 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+type ClosePlaybackRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlaybackId string `protobuf:"bytes,1,opt,name=playback_id,json=playbackId,proto3" json:"playback_id,omitempty"`
+}
+
+func (x *ClosePlaybackRequest) Reset() {
+	*x = ClosePlaybackRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClosePlaybackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClosePlaybackRequest) ProtoMessage() {}
+
+func (x *ClosePlaybackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClosePlaybackRequest.ProtoReflect.Descriptor instead.
+func (*ClosePlaybackRequest) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ClosePlaybackRequest) GetPlaybackId() string {
+	if x != nil {
+		return x.PlaybackId
+	}
+	return ""
+}
+
+type SaveLayoutRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JsonLayout []byte `protobuf:"bytes,1,opt,name=json_layout,json=jsonLayout,proto3" json:"json_layout,omitempty"` // serialized layout JSON
+}
+
+func (x *SaveLayoutRequest) Reset() {
+	*x = SaveLayoutRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveLayoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveLayoutRequest) ProtoMessage() {}
+
+func (x *SaveLayoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveLayoutRequest.ProtoReflect.Descriptor instead.
+func (*SaveLayoutRequest) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *SaveLayoutRequest) GetJsonLayout() []byte {
+	if x != nil {
+		return x.JsonLayout
+	}
+	return nil
+}
+
+type LoadLayoutResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JsonLayout []byte `protobuf:"bytes,1,opt,name=json_layout,json=jsonLayout,proto3" json:"json_layout,omitempty"`
+}
+
+func (x *LoadLayoutResponse) Reset() {
+	*x = LoadLayoutResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadLayoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadLayoutResponse) ProtoMessage() {}
+
+func (x *LoadLayoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadLayoutResponse.ProtoReflect.Descriptor instead.
+func (*LoadLayoutResponse) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *LoadLayoutResponse) GetJsonLayout() []byte {
+	if x != nil {
+		return x.JsonLayout
+	}
+	return nil
+}
+
+type PingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *PingRequest) Reset() {
+	*x = PingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingRequest) ProtoMessage() {}
+
+func (x *PingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingRequest.ProtoReflect.Descriptor instead.
+func (*PingRequest) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *PingRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type PingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *PingResponse) Reset() {
+	*x = PingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingResponse) ProtoMessage() {}
+
+func (x *PingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingResponse.ProtoReflect.Descriptor instead.
+func (*PingResponse) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *PingResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type VersionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Build   string `protobuf:"bytes,2,opt,name=build,proto3" json:"build,omitempty"`
+}
+
+func (x *VersionResponse) Reset() {
+	*x = VersionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VersionResponse) ProtoMessage() {}
+
+func (x *VersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VersionResponse.ProtoReflect.Descriptor instead.
+func (*VersionResponse) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *VersionResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *VersionResponse) GetBuild() string {
+	if x != nil {
+		return x.Build
+	}
+	return ""
+}
+
+type Ack struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ok bool `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+}
+
+func (x *Ack) Reset() {
+	*x = Ack{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockterm_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Ack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ack) ProtoMessage() {}
+
+func (x *Ack) ProtoReflect() protoreflect.Message {
+	mi := &file_blockterm_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ack.ProtoReflect.Descriptor instead.
+func (*Ack) Descriptor() ([]byte, []int) {
+	return file_blockterm_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *Ack) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+var File_blockterm_proto protoreflect.FileDescriptor
+
+var file_blockterm_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x1a, 0x1b, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x90, 0x03, 0x0a, 0x13, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x65, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x73, 0x68, 0x65, 0x6c, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x77, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x77, 0x64, 0x12, 0x39, 0x0a, 0x03, 0x65, 0x6e, 0x76,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65,
+	0x72, 0x6d, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x03, 0x65, 0x6e, 0x76, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x04, 0x63, 0x6f, 0x6c, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x49, 0x64, 0x1a, 0x36, 0x0a, 0x08, 0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x56, 0x0a, 0x14,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x7d, 0x0a, 0x13, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x67, 0x72,
+	0x61, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0d, 0x67, 0x72, 0x61, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x4d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x58, 0x0a, 0x14, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x6f,
+	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x30, 0x0a, 0x06, 0x6b,
+	0x69, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x4b, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x06, 0x6b, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x22, 0x75, 0x0a,
+	0x0d, 0x4b, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x30,
+	0x0a, 0x07, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x75, 0x72, 0x76,
+	0x69, 0x76, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x75, 0x72, 0x76,
+	0x69, 0x76, 0x65, 0x64, 0x22, 0x60, 0x0a, 0x0a, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x43, 0x68, 0x75,
+	0x6e, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xbd, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12,
+	0x27, 0x0a, 0x0f, 0x6f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x76, 0x65, 0x72, 0x66, 0x6c,
+	0x6f, 0x77, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x5f, 0x73, 0x65, 0x71, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x53, 0x65, 0x71, 0x22, 0x81, 0x04, 0x0a, 0x0b, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12,
+	0x33, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x12, 0x2f,
+	0x0a, 0x06, 0x72, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69,
+	0x6e, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x06, 0x72, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x12,
+	0x2a, 0x0a, 0x04, 0x65, 0x78, 0x69, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x45, 0x78, 0x69, 0x74, 0x52, 0x04, 0x65, 0x78, 0x69, 0x74, 0x12, 0x2d, 0x0a, 0x05, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x07, 0x74, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x48,
+	0x69, 0x74, 0x52, 0x07, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x73,
+	0x65, 0x71, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x1b, 0x0a,
+	0x09, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x42, 0x79, 0x22, 0x80, 0x01, 0x0a, 0x0b, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x69, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78,
+	0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65,
+	0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12,
+	0x24, 0x0a, 0x0e, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x75, 0x73, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x42,
+	0x79, 0x55, 0x73, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x70, 0x65, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x70, 0x65, 0x64, 0x22, 0x90, 0x02,
+	0x0a, 0x14, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0xb5, 0x02, 0x0a, 0x15, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x65, 0x6e, 0x64, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x65, 0x6e, 0x64, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x2a, 0x0a, 0x04, 0x65, 0x78, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x45, 0x78, 0x69, 0x74, 0x52, 0x04, 0x65, 0x78, 0x69, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x42, 0x79, 0x22, 0x69, 0x0a, 0x13, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f,
+	0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x66, 0x0a, 0x14, 0x53, 0x68, 0x61, 0x72, 0x65, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x52, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x73, 0x68, 0x61, 0x72, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x6f, 0x0a, 0x12, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x73, 0x68, 0x61, 0x72, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xa3, 0x01, 0x0a,
+	0x0c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x73, 0x68, 0x61, 0x72, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x2b, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e,
+	0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x22, 0xaf, 0x02, 0x0a, 0x0e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x4d, 0x0a, 0x0c, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x73, 0x68, 0x61, 0x72, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x3e, 0x0a, 0x10, 0x53, 0x68, 0x61, 0x72, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0xee, 0x01, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42,
+	0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x5a, 0x0a, 0x0c, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x37, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x73, 0x68, 0x61, 0x72, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x1a, 0x3e, 0x0a, 0x10, 0x53, 0x68, 0x61, 0x72, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x59, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42,
+	0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x50, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73,
+	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x31, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x42, 0x72, 0x6f, 0x61,
+	0x64, 0x63, 0x61, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x22, 0x73, 0x0a, 0x0f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xa9, 0x01, 0x0a, 0x10, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x64, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x46, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0x44, 0x0a, 0x0d, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x46, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xd8, 0x02, 0x0a, 0x11, 0x52, 0x75,
+	0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x77, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x77, 0x64, 0x12, 0x37, 0x0a, 0x03, 0x65,
+	0x6e, 0x76, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x74, 0x65, 0x72, 0x6d, 0x2e, 0x52, 0x75, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x03, 0x65, 0x6e, 0x76, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x65, 0x6c, 0x6c, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x65, 0x6c, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c, 0x6f,
+	0x67, 0x69, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x4d, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6d, 0x61,
+	0x78, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x1a, 0x36, 0x0a, 0x08,
+	0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x8b, 0x02, 0x0a, 0x10, 0x52, 0x75, 0x6e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x69,
+	0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x78,
+	0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12, 0x1b,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x74,
+	0x64, 0x6f, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x12, 0x29, 0x0a, 0x10,
+	0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x5f, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x54, 0x72,
+	0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x74, 0x64, 0x65, 0x72,
+	0x72, 0x5f, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0f, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74,
+	0x65, 0x64, 0x22, 0x88, 0x01, 0x0a, 0x0f, 0x52, 0x75, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65,
+	0x72, 0x6d, 0x2e, 0x52, 0x75, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x35, 0x0a,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x52, 0x75, 0x6e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x3e, 0x0a,
+	0x10, 0x52, 0x75, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xa6, 0x01,
+	0x0a, 0x0c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61,
+	0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x65, 0x61,
+	0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65,
+	0x72, 0x6d, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x48, 0x69, 0x74, 0x52, 0x07, 0x74,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x22, 0xd6, 0x01, 0x0a, 0x0a, 0x54, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x48, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x65, 0x78, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x64, 0x22,
+	0x36, 0x0a, 0x0c, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x63,
+	0x6f, 0x6c, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x22, 0x60, 0x0a, 0x13, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31,
+	0x0a, 0x03, 0x61, 0x64, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x03, 0x61, 0x64,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x22, 0x87, 0x01, 0x0a, 0x14, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x12, 0x34, 0x0a, 0x05, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x64, 0x65,
+	0x64, 0x48, 0x00, 0x52, 0x05, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x66, 0x72,
+	0x61, 0x6d, 0x65, 0x22, 0x64, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xbe, 0x01, 0x0a, 0x0d, 0x41, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x04, 0x6f,
+	0x70, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x4f, 0x70, 0x65, 0x6e,
+	0x48, 0x00, 0x52, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74,
+	0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x54, 0x65, 0x72,
+	0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73,
+	0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12, 0x12, 0x0a,
+	0x03, 0x61, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x03, 0x61, 0x63,
+	0x6b, 0x42, 0x07, 0x0a, 0x05, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x22, 0xcd, 0x01, 0x0a, 0x0a, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x4f, 0x70, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6d,
+	0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x66,
+	0x72, 0x6f, 0x6d, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x76, 0x65,
+	0x72, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x6f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a,
+	0x09, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x71, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x61, 0x66, 0x74, 0x65, 0x72, 0x53, 0x65, 0x71, 0x22, 0xbb, 0x01, 0x0a, 0x0e, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a,
+	0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x43, 0x68, 0x75, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12,
+	0x3e, 0x0a, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x65, 0x64, 0x12,
+	0x2e, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42,
+	0x07, 0x0a, 0x05, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x22, 0x3d, 0x0a, 0x0b, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x72, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x47, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x03, 0x70, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x61, 0x72, 0x67, 0x76, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x76,
+	0x22, 0x6b, 0x0a, 0x12, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x66, 0x6f, 0x72, 0x65, 0x67, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x0a, 0x66, 0x6f, 0x72, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x61, 0x6c, 0x74, 0x5f, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x61, 0x6c, 0x74, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x22, 0x7e, 0x0a,
+	0x14, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x04, 0x63, 0x6f, 0x6c, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x53, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x56, 0x0a, 0x14, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x98, 0x05, 0x0a, 0x0b, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x65,
+	0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x65, 0x6c, 0x6c, 0x12,
+	0x10, 0x0a, 0x03, 0x63, 0x77, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x77,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x04, 0x63, 0x6f, 0x6c, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2c,
+	0x0a, 0x12, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x65,
+	0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x45, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x29, 0x0a, 0x10, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x61, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65,
+	0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x64, 0x65, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x12, 0x36, 0x0a, 0x0a, 0x66, 0x6f, 0x72, 0x65, 0x67, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x74, 0x65, 0x72, 0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x0a, 0x66, 0x6f, 0x72, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x61, 0x6c, 0x74, 0x5f, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x61, 0x6c, 0x74, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x2f,
+	0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12,
+	0x23, 0x0a, 0x0d, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x4f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x4a, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a,
+	0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x56, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x77, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x3d, 0x0a, 0x09, 0x43, 0x77, 0x64,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x47, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43,
+	0x77, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2e, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x43,
+	0x77, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x22, 0x6f, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x4d, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x22, 0x6e, 0x0a, 0x14, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x3f, 0x0a, 0x15, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x67,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x67, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x04, 0x70, 0x69,
+	0x64, 0x73, 0x22, 0x6b, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e,
+	0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x5f, 0x70, 0x6f, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x50, 0x6f, 0x73, 0x22,
+	0x4e, 0x0a, 0x0a, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22,
+	0x51, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0b, 0x73, 0x75, 0x67,
+	0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0xaf, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x77, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x63, 0x77, 0x64, 0x12, 0x20, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x08, 0x65, 0x78, 0x69,
+	0x74, 0x43, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x65, 0x78, 0x69, 0x74, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x22, 0x41, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x51, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x39, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x9a, 0x02, 0x0a, 0x0d, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0c,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69,
+	0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x6c, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x63, 0x6f, 0x6c, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72,
+	0x6f, 0x77, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x52, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x38, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72,
+	0x6d, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x3b, 0x0a, 0x16, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69,
+	0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x22, 0xb9, 0x02, 0x0a, 0x07, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x65, 0x6c,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x65, 0x6c, 0x6c, 0x12, 0x12,
+	0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72,
+	0x67, 0x73, 0x12, 0x2d, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x2e, 0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x65, 0x6e,
+	0x76, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x77, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x63, 0x77, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x74, 0x61, 0x72, 0x74, 0x75, 0x70, 0x5f, 0x63,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x75, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x1a, 0x36, 0x0a, 0x08,
+	0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0xa5, 0x02, 0x0a, 0x07, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73,
+	0x63, 0x6f, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x67, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x72, 0x67, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x46, 0x0a, 0x14,
+	0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65,
+	0x72, 0x6d, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x08, 0x74, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x73, 0x22, 0x32, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x49, 0x64, 0x22, 0x35, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0x46, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x32, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x35, 0x0a, 0x14, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x49, 0x64, 0x22, 0xad, 0x01, 0x0a, 0x13, 0x4f, 0x70, 0x65, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x62,
+	0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x69, 0x64, 0x6c, 0x65, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0d, 0x69, 0x64, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x61, 0x75, 0x73,
+	0x65, 0x64, 0x22, 0xdd, 0x01, 0x0a, 0x0c, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x62, 0x61,
+	0x63, 0x6b, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
+	0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x63, 0x6f, 0x6c, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x6f,
+	0x77, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61,
+	0x75, 0x73, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73,
+	0x65, 0x64, 0x22, 0x38, 0x0a, 0x15, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79,
+	0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70,
+	0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x22, 0x88, 0x01, 0x0a,
+	0x16, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x62,
+	0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c,
+	0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x22, 0x37, 0x0a, 0x14, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x49, 0x64,
+	0x22, 0x34, 0x0a, 0x11, 0x53, 0x61, 0x76, 0x65, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x6c, 0x61,
+	0x79, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6a, 0x73, 0x6f, 0x6e,
+	0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x22, 0x35, 0x0a, 0x12, 0x4c, 0x6f, 0x61, 0x64, 0x4c, 0x61,
+	0x79, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0a, 0x6a, 0x73, 0x6f, 0x6e, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x22, 0x27, 0x0a,
+	0x0b, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x28, 0x0a, 0x0c, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x41, 0x0a, 0x0f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x75,
+	0x69, 0x6c, 0x64, 0x22, 0x15, 0x0a, 0x03, 0x41, 0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6b,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x6f, 0x6b, 0x32, 0xb8, 0x0e, 0x0a, 0x0f, 0x54,
+	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4f,
+	0x0a, 0x0c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e,
+	0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4f, 0x0a, 0x0c, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x1e, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x34, 0x0a, 0x09, 0x53, 0x65, 0x6e, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x15, 0x2e,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x43,
+	0x68, 0x75, 0x6e, 0x6b, 0x1a, 0x0e, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d,
+	0x2e, 0x41, 0x63, 0x6b, 0x28, 0x01, 0x12, 0x4a, 0x0a, 0x0d, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1f, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74,
+	0x65, 0x72, 0x6d, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x74, 0x65, 0x72, 0x6d, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b,
+	0x30, 0x01, 0x12, 0x41, 0x0a, 0x06, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x12, 0x18, 0x2e, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65,
+	0x72, 0x6d, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x28, 0x01, 0x30, 0x01, 0x12, 0x53, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x41, 0x6c, 0x6c, 0x12, 0x1e, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72,
+	0x6d, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x41, 0x6c, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72,
+	0x6d, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x41, 0x6c, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x30, 0x01, 0x12, 0x40, 0x0a, 0x0d, 0x52, 0x65,
+	0x73, 0x69, 0x7a, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x41, 0x63, 0x6b, 0x12, 0x47, 0x0a, 0x0c,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x40, 0x0a, 0x0d, 0x44, 0x65, 0x74,
+	0x61, 0x63, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x41, 0x63, 0x6b, 0x12, 0x52, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x43, 0x77, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1f, 0x2e, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x77, 0x64, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x77, 0x64,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x52, 0x0a, 0x0d, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x1f, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x6c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x69, 0x6e, 0x67, 0x12, 0x1e, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e,
+	0x53, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e,
+	0x53, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x12, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x17, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x47, 0x0a,
+	0x0a, 0x52, 0x75, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x1c, 0x2e, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x52, 0x75, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x52, 0x75, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x4b, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x1c, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74,
+	0x65, 0x72, 0x6d, 0x2e, 0x52, 0x75, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72,
+	0x6d, 0x2e, 0x52, 0x75, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x30, 0x01, 0x12, 0x59, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x72, 0x6f,
+	0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x26, 0x2e, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x72,
+	0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e,
+	0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x4c,
+	0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73,
+	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x19, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65,
+	0x72, 0x6d, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x1a, 0x19, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x42, 0x72,
+	0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x4e, 0x0a, 0x14,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x12, 0x26, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x41, 0x63, 0x6b, 0x12, 0x55, 0x0a, 0x13,
+	0x4c, 0x69, 0x73, 0x74, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x26, 0x2e, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x72, 0x6f, 0x61,
+	0x64, 0x63, 0x61, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x53, 0x65, 0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x1a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72,
+	0x6d, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x43, 0x68, 0x75, 0x6e,
+	0x6b, 0x1a, 0x1b, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x28, 0x01,
+	0x30, 0x01, 0x12, 0x4f, 0x0a, 0x0c, 0x53, 0x68, 0x61, 0x72, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x12, 0x1d, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0e, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x41, 0x63,
+	0x6b, 0x12, 0x52, 0x0a, 0x0d, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x12, 0x1f, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x57,
+	0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e,
+	0x57, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x6a, 0x0a, 0x11, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x55, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x2e, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x67, 0x67,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75,
+	0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x32, 0xa3, 0x01, 0x0a, 0x0e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x40, 0x0a, 0x0d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x43, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x1f, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72,
+	0x6d, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65,
+	0x72, 0x6d, 0x2e, 0x41, 0x63, 0x6b, 0x12, 0x4f, 0x0a, 0x0c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1e, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65,
+	0x72, 0x6d, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65,
+	0x72, 0x6d, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xa5, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4b, 0x0a, 0x0e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x21, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65,
+	0x72, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0f, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x2e, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0e, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x41, 0x63, 0x6b, 0x32,
+	0xb9, 0x02, 0x0a, 0x0f, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x4f, 0x70, 0x65, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x62,
+	0x61, 0x63, 0x6b, 0x12, 0x1e, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e,
+	0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x4c, 0x0a, 0x0e,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x20,
+	0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x30, 0x01, 0x12, 0x4d, 0x0a, 0x0f, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x21, 0x2e,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x17, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x50, 0x6c, 0x61,
+	0x79, 0x62, 0x61, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x40, 0x0a, 0x0d, 0x43, 0x6c, 0x6f,
+	0x73, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x1f, 0x2e, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x6c, 0x61, 0x79,
+	0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x41, 0x63, 0x6b, 0x32, 0xcd, 0x02, 0x0a, 0x0e,
+	0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x37,
+	0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12,
+	0x12, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x54, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x1a, 0x12, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e,
+	0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x47, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x1f, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3e, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x1c,
+	0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x12, 0x37, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x12, 0x12, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x54, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x1a, 0x12, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72,
+	0x6d, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x0d, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x41, 0x63, 0x6b, 0x32, 0xcd, 0x02, 0x0a, 0x0e,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x37,
+	0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12,
+	0x12, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x1a, 0x12, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x1f, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3e, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1c,
+	0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x12, 0x37, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x12, 0x12, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x1a, 0x12, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72,
+	0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x40, 0x0a, 0x0d, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1f, 0x2e, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x41, 0x63, 0x6b, 0x32, 0x93, 0x01, 0x0a, 0x10,
+	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x3a, 0x0a, 0x0a, 0x53, 0x61, 0x76, 0x65, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x1c,
+	0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x4c,
+	0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x41, 0x63, 0x6b, 0x12, 0x43, 0x0a, 0x0a,
+	0x4c, 0x6f, 0x61, 0x64, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x1d, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x4c,
+	0x6f, 0x61, 0x64, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x32, 0x8a, 0x01, 0x0a, 0x0d, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x2e, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e,
+	0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0a,
+	0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x65, 0x72, 0x6d, 0x2e, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x1b,
+	0x5a, 0x19, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x6e, 0x74,
+	0x6c, 0x2f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x65, 0x72, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_blockterm_proto_rawDescOnce sync.Once
+	file_blockterm_proto_rawDescData = file_blockterm_proto_rawDesc
+)
+
+func file_blockterm_proto_rawDescGZIP() []byte {
+	file_blockterm_proto_rawDescOnce.Do(func() {
+		file_blockterm_proto_rawDescData = protoimpl.X.CompressGZIP(file_blockterm_proto_rawDescData)
+	})
+	return file_blockterm_proto_rawDescData
+}
+
+var file_blockterm_proto_msgTypes = make([]protoimpl.MessageInfo, 83)
+var file_blockterm_proto_goTypes = []any{
+	(*StartSessionRequest)(nil),         // 0: blockterm.StartSessionRequest
+	(*StartSessionResponse)(nil),        // 1: blockterm.StartSessionResponse
+	(*CloseSessionRequest)(nil),         // 2: blockterm.CloseSessionRequest
+	(*CloseSessionResponse)(nil),        // 3: blockterm.CloseSessionResponse
+	(*KilledProcess)(nil),               // 4: blockterm.KilledProcess
+	(*InputChunk)(nil),                  // 5: blockterm.InputChunk
+	(*ReceiveOutputRequest)(nil),        // 6: blockterm.ReceiveOutputRequest
+	(*OutputChunk)(nil),                 // 7: blockterm.OutputChunk
+	(*SessionExit)(nil),                 // 8: blockterm.SessionExit
+	(*WaitForOutputRequest)(nil),        // 9: blockterm.WaitForOutputRequest
+	(*WaitForOutputResponse)(nil),       // 10: blockterm.WaitForOutputResponse
+	(*ShareSessionRequest)(nil),         // 11: blockterm.ShareSessionRequest
+	(*ShareSessionResponse)(nil),        // 12: blockterm.ShareSessionResponse
+	(*RevokeShareRequest)(nil),          // 13: blockterm.RevokeShareRequest
+	(*SessionShare)(nil),                // 14: blockterm.SessionShare
+	(*BroadcastGroup)(nil),              // 15: blockterm.BroadcastGroup
+	(*CreateBroadcastGroupRequest)(nil), // 16: blockterm.CreateBroadcastGroupRequest
+	(*DeleteBroadcastGroupRequest)(nil), // 17: blockterm.DeleteBroadcastGroupRequest
+	(*ListBroadcastGroupsResponse)(nil), // 18: blockterm.ListBroadcastGroupsResponse
+	(*GroupInputChunk)(nil),             // 19: blockterm.GroupInputChunk
+	(*GroupInputResult)(nil),            // 20: blockterm.GroupInputResult
+	(*MemberFailure)(nil),               // 21: blockterm.MemberFailure
+	(*RunCommandRequest)(nil),           // 22: blockterm.RunCommandRequest
+	(*RunCommandResult)(nil),            // 23: blockterm.RunCommandResult
+	(*RunCommandEvent)(nil),             // 24: blockterm.RunCommandEvent
+	(*RunCommandOutput)(nil),            // 25: blockterm.RunCommandOutput
+	(*SessionEvent)(nil),                // 26: blockterm.SessionEvent
+	(*TriggerHit)(nil),                  // 27: blockterm.TriggerHit
+	(*TerminalSize)(nil),                // 28: blockterm.TerminalSize
+	(*SubscribeAllRequest)(nil),         // 29: blockterm.SubscribeAllRequest
+	(*SubscribeAllResponse)(nil),        // 30: blockterm.SubscribeAllResponse
+	(*SubscriptionEnded)(nil),           // 31: blockterm.SubscriptionEnded
+	(*AttachRequest)(nil),               // 32: blockterm.AttachRequest
+	(*AttachOpen)(nil),                  // 33: blockterm.AttachOpen
+	(*AttachResponse)(nil),              // 34: blockterm.AttachResponse
+	(*AttachError)(nil),                 // 35: blockterm.AttachError
+	(*ProcessInfo)(nil),                 // 36: blockterm.ProcessInfo
+	(*SessionStateChange)(nil),          // 37: blockterm.SessionStateChange
+	(*ResizeSessionRequest)(nil),        // 38: blockterm.ResizeSessionRequest
+	(*GetSessionRequest)(nil),           // 39: blockterm.GetSessionRequest
+	(*DetachSessionRequest)(nil),        // 40: blockterm.DetachSessionRequest
+	(*SessionInfo)(nil),                 // 41: blockterm.SessionInfo
+	(*ListSessionsResponse)(nil),        // 42: blockterm.ListSessionsResponse
+	(*GetCwdHistoryRequest)(nil),        // 43: blockterm.GetCwdHistoryRequest
+	(*CwdChange)(nil),                   // 44: blockterm.CwdChange
+	(*GetCwdHistoryResponse)(nil),       // 45: blockterm.GetCwdHistoryResponse
+	(*SetRecordingRequest)(nil),         // 46: blockterm.SetRecordingRequest
+	(*SetRecordingResponse)(nil),        // 47: blockterm.SetRecordingResponse
+	(*SignalSessionRequest)(nil),        // 48: blockterm.SignalSessionRequest
+	(*SignalSessionResponse)(nil),       // 49: blockterm.SignalSessionResponse
+	(*GetSuggestionsRequest)(nil),       // 50: blockterm.GetSuggestionsRequest
+	(*Suggestion)(nil),                  // 51: blockterm.Suggestion
+	(*GetSuggestionsResponse)(nil),      // 52: blockterm.GetSuggestionsResponse
+	(*RecordCommandRequest)(nil),        // 53: blockterm.RecordCommandRequest
+	(*QueryHistoryRequest)(nil),         // 54: blockterm.QueryHistoryRequest
+	(*QueryHistoryResponse)(nil),        // 55: blockterm.QueryHistoryResponse
+	(*RecordingInfo)(nil),               // 56: blockterm.RecordingInfo
+	(*ListRecordingsResponse)(nil),      // 57: blockterm.ListRecordingsResponse
+	(*DeleteRecordingRequest)(nil),      // 58: blockterm.DeleteRecordingRequest
+	(*Profile)(nil),                     // 59: blockterm.Profile
+	(*Trigger)(nil),                     // 60: blockterm.Trigger
+	(*ListTriggersResponse)(nil),        // 61: blockterm.ListTriggersResponse
+	(*GetTriggerRequest)(nil),           // 62: blockterm.GetTriggerRequest
+	(*DeleteTriggerRequest)(nil),        // 63: blockterm.DeleteTriggerRequest
+	(*ListProfilesResponse)(nil),        // 64: blockterm.ListProfilesResponse
+	(*GetProfileRequest)(nil),           // 65: blockterm.GetProfileRequest
+	(*DeleteProfileRequest)(nil),        // 66: blockterm.DeleteProfileRequest
+	(*OpenPlaybackRequest)(nil),         // 67: blockterm.OpenPlaybackRequest
+	(*PlaybackInfo)(nil),                // 68: blockterm.PlaybackInfo
+	(*StreamPlaybackRequest)(nil),       // 69: blockterm.StreamPlaybackRequest
+	(*ControlPlaybackRequest)(nil),      // 70: blockterm.ControlPlaybackRequest
+	(*ClosePlaybackRequest)(nil),        // 71: blockterm.ClosePlaybackRequest
+	(*SaveLayoutRequest)(nil),           // 72: blockterm.SaveLayoutRequest
+	(*LoadLayoutResponse)(nil),          // 73: blockterm.LoadLayoutResponse
+	(*PingRequest)(nil),                 // 74: blockterm.PingRequest
+	(*PingResponse)(nil),                // 75: blockterm.PingResponse
+	(*VersionResponse)(nil),             // 76: blockterm.VersionResponse
+	(*Ack)(nil),                         // 77: blockterm.Ack
+	nil,                                 // 78: blockterm.StartSessionRequest.EnvEntry
+	nil,                                 // 79: blockterm.BroadcastGroup.ShareTokensEntry
+	nil,                                 // 80: blockterm.CreateBroadcastGroupRequest.ShareTokensEntry
+	nil,                                 // 81: blockterm.RunCommandRequest.EnvEntry
+	nil,                                 // 82: blockterm.Profile.EnvEntry
+	(*emptypb.Empty)(nil),               // 83: google.protobuf.Empty
+}
+var file_blockterm_proto_depIdxs = []int32{
+	78, // 0: blockterm.StartSessionRequest.env:type_name -> blockterm.StartSessionRequest.EnvEntry
+	4,  // 1: blockterm.CloseSessionResponse.killed:type_name -> blockterm.KilledProcess
+	36, // 2: blockterm.KilledProcess.process:type_name -> blockterm.ProcessInfo
+	37, // 3: blockterm.OutputChunk.state:type_name -> blockterm.SessionStateChange
+	28, // 4: blockterm.OutputChunk.resize:type_name -> blockterm.TerminalSize
+	8,  // 5: blockterm.OutputChunk.exit:type_name -> blockterm.SessionExit
+	26, // 6: blockterm.OutputChunk.event:type_name -> blockterm.SessionEvent
+	27, // 7: blockterm.OutputChunk.trigger:type_name -> blockterm.TriggerHit
+	8,  // 8: blockterm.WaitForOutputResponse.exit:type_name -> blockterm.SessionExit
+	14, // 9: blockterm.ShareSessionResponse.share:type_name -> blockterm.SessionShare
+	28, // 10: blockterm.SessionShare.size:type_name -> blockterm.TerminalSize
+	79, // 11: blockterm.BroadcastGroup.share_tokens:type_name -> blockterm.BroadcastGroup.ShareTokensEntry
+	80, // 12: blockterm.CreateBroadcastGroupRequest.share_tokens:type_name -> blockterm.CreateBroadcastGroupRequest.ShareTokensEntry
+	15, // 13: blockterm.ListBroadcastGroupsResponse.groups:type_name -> blockterm.BroadcastGroup
+	21, // 14: blockterm.GroupInputResult.failures:type_name -> blockterm.MemberFailure
+	81, // 15: blockterm.RunCommandRequest.env:type_name -> blockterm.RunCommandRequest.EnvEntry
+	25, // 16: blockterm.RunCommandEvent.output:type_name -> blockterm.RunCommandOutput
+	23, // 17: blockterm.RunCommandEvent.result:type_name -> blockterm.RunCommandResult
+	27, // 18: blockterm.SessionEvent.trigger:type_name -> blockterm.TriggerHit
+	6,  // 19: blockterm.SubscribeAllRequest.add:type_name -> blockterm.ReceiveOutputRequest
+	7,  // 20: blockterm.SubscribeAllResponse.output:type_name -> blockterm.OutputChunk
+	31, // 21: blockterm.SubscribeAllResponse.ended:type_name -> blockterm.SubscriptionEnded
+	33, // 22: blockterm.AttachRequest.open:type_name -> blockterm.AttachOpen
+	28, // 23: blockterm.AttachRequest.resize:type_name -> blockterm.TerminalSize
+	7,  // 24: blockterm.AttachResponse.output:type_name -> blockterm.OutputChunk
+	49, // 25: blockterm.AttachResponse.signaled:type_name -> blockterm.SignalSessionResponse
+	35, // 26: blockterm.AttachResponse.error:type_name -> blockterm.AttachError
+	36, // 27: blockterm.SessionStateChange.foreground:type_name -> blockterm.ProcessInfo
+	36, // 28: blockterm.SessionInfo.foreground:type_name -> blockterm.ProcessInfo
+	14, // 29: blockterm.SessionInfo.shares:type_name -> blockterm.SessionShare
+	41, // 30: blockterm.ListSessionsResponse.sessions:type_name -> blockterm.SessionInfo
+	44, // 31: blockterm.GetCwdHistoryResponse.entries:type_name -> blockterm.CwdChange
+	51, // 32: blockterm.GetSuggestionsResponse.suggestions:type_name -> blockterm.Suggestion
+	53, // 33: blockterm.QueryHistoryResponse.entries:type_name -> blockterm.RecordCommandRequest
+	56, // 34: blockterm.ListRecordingsResponse.recordings:type_name -> blockterm.RecordingInfo
+	82, // 35: blockterm.Profile.env:type_name -> blockterm.Profile.EnvEntry
+	60, // 36: blockterm.ListTriggersResponse.triggers:type_name -> blockterm.Trigger
+	59, // 37: blockterm.ListProfilesResponse.profiles:type_name -> blockterm.Profile
+	0,  // 38: blockterm.TerminalService.StartSession:input_type -> blockterm.StartSessionRequest
+	2,  // 39: blockterm.TerminalService.CloseSession:input_type -> blockterm.CloseSessionRequest
+	5,  // 40: blockterm.TerminalService.SendInput:input_type -> blockterm.InputChunk
+	6,  // 41: blockterm.TerminalService.ReceiveOutput:input_type -> blockterm.ReceiveOutputRequest
+	32, // 42: blockterm.TerminalService.Attach:input_type -> blockterm.AttachRequest
+	29, // 43: blockterm.TerminalService.SubscribeAll:input_type -> blockterm.SubscribeAllRequest
+	38, // 44: blockterm.TerminalService.ResizeSession:input_type -> blockterm.ResizeSessionRequest
+	83, // 45: blockterm.TerminalService.ListSessions:input_type -> google.protobuf.Empty
+	39, // 46: blockterm.TerminalService.GetSession:input_type -> blockterm.GetSessionRequest
+	40, // 47: blockterm.TerminalService.DetachSession:input_type -> blockterm.DetachSessionRequest
+	43, // 48: blockterm.TerminalService.GetCwdHistory:input_type -> blockterm.GetCwdHistoryRequest
+	48, // 49: blockterm.TerminalService.SignalSession:input_type -> blockterm.SignalSessionRequest
+	46, // 50: blockterm.TerminalService.SetRecording:input_type -> blockterm.SetRecordingRequest
+	83, // 51: blockterm.TerminalService.WatchSessionEvents:input_type -> google.protobuf.Empty
+	22, // 52: blockterm.TerminalService.RunCommand:input_type -> blockterm.RunCommandRequest
+	22, // 53: blockterm.TerminalService.StreamCommand:input_type -> blockterm.RunCommandRequest
+	16, // 54: blockterm.TerminalService.CreateBroadcastGroup:input_type -> blockterm.CreateBroadcastGroupRequest
+	15, // 55: blockterm.TerminalService.UpdateBroadcastGroup:input_type -> blockterm.BroadcastGroup
+	17, // 56: blockterm.TerminalService.DeleteBroadcastGroup:input_type -> blockterm.DeleteBroadcastGroupRequest
+	83, // 57: blockterm.TerminalService.ListBroadcastGroups:input_type -> google.protobuf.Empty
+	19, // 58: blockterm.TerminalService.SendGroupInput:input_type -> blockterm.GroupInputChunk
+	11, // 59: blockterm.TerminalService.ShareSession:input_type -> blockterm.ShareSessionRequest
+	13, // 60: blockterm.TerminalService.RevokeShare:input_type -> blockterm.RevokeShareRequest
+	9,  // 61: blockterm.TerminalService.WaitForOutput:input_type -> blockterm.WaitForOutputRequest
+	50, // 62: blockterm.SuggestionService.GetSuggestions:input_type -> blockterm.GetSuggestionsRequest
+	53, // 63: blockterm.HistoryService.RecordCommand:input_type -> blockterm.RecordCommandRequest
+	54, // 64: blockterm.HistoryService.QueryHistory:input_type -> blockterm.QueryHistoryRequest
+	83, // 65: blockterm.RecordingService.ListRecordings:input_type -> google.protobuf.Empty
+	58, // 66: blockterm.RecordingService.DeleteRecording:input_type -> blockterm.DeleteRecordingRequest
+	67, // 67: blockterm.PlaybackService.OpenPlayback:input_type -> blockterm.OpenPlaybackRequest
+	69, // 68: blockterm.PlaybackService.StreamPlayback:input_type -> blockterm.StreamPlaybackRequest
+	70, // 69: blockterm.PlaybackService.ControlPlayback:input_type -> blockterm.ControlPlaybackRequest
+	71, // 70: blockterm.PlaybackService.ClosePlayback:input_type -> blockterm.ClosePlaybackRequest
+	60, // 71: blockterm.TriggerService.CreateTrigger:input_type -> blockterm.Trigger
+	83, // 72: blockterm.TriggerService.ListTriggers:input_type -> google.protobuf.Empty
+	62, // 73: blockterm.TriggerService.GetTrigger:input_type -> blockterm.GetTriggerRequest
+	60, // 74: blockterm.TriggerService.UpdateTrigger:input_type -> blockterm.Trigger
+	63, // 75: blockterm.TriggerService.DeleteTrigger:input_type -> blockterm.DeleteTriggerRequest
+	59, // 76: blockterm.ProfileService.CreateProfile:input_type -> blockterm.Profile
+	83, // 77: blockterm.ProfileService.ListProfiles:input_type -> google.protobuf.Empty
+	65, // 78: blockterm.ProfileService.GetProfile:input_type -> blockterm.GetProfileRequest
+	59, // 79: blockterm.ProfileService.UpdateProfile:input_type -> blockterm.Profile
+	66, // 80: blockterm.ProfileService.DeleteProfile:input_type -> blockterm.DeleteProfileRequest
+	72, // 81: blockterm.WorkspaceService.SaveLayout:input_type -> blockterm.SaveLayoutRequest
+	83, // 82: blockterm.WorkspaceService.LoadLayout:input_type -> google.protobuf.Empty
+	74, // 83: blockterm.SystemService.Ping:input_type -> blockterm.PingRequest
+	83, // 84: blockterm.SystemService.GetVersion:input_type -> google.protobuf.Empty
+	1,  // 85: blockterm.TerminalService.StartSession:output_type -> blockterm.StartSessionResponse
+	3,  // 86: blockterm.TerminalService.CloseSession:output_type -> blockterm.CloseSessionResponse
+	77, // 87: blockterm.TerminalService.SendInput:output_type -> blockterm.Ack
+	7,  // 88: blockterm.TerminalService.ReceiveOutput:output_type -> blockterm.OutputChunk
+	34, // 89: blockterm.TerminalService.Attach:output_type -> blockterm.AttachResponse
+	30, // 90: blockterm.TerminalService.SubscribeAll:output_type -> blockterm.SubscribeAllResponse
+	77, // 91: blockterm.TerminalService.ResizeSession:output_type -> blockterm.Ack
+	42, // 92: blockterm.TerminalService.ListSessions:output_type -> blockterm.ListSessionsResponse
+	41, // 93: blockterm.TerminalService.GetSession:output_type -> blockterm.SessionInfo
+	77, // 94: blockterm.TerminalService.DetachSession:output_type -> blockterm.Ack
+	45, // 95: blockterm.TerminalService.GetCwdHistory:output_type -> blockterm.GetCwdHistoryResponse
+	49, // 96: blockterm.TerminalService.SignalSession:output_type -> blockterm.SignalSessionResponse
+	47, // 97: blockterm.TerminalService.SetRecording:output_type -> blockterm.SetRecordingResponse
+	26, // 98: blockterm.TerminalService.WatchSessionEvents:output_type -> blockterm.SessionEvent
+	23, // 99: blockterm.TerminalService.RunCommand:output_type -> blockterm.RunCommandResult
+	24, // 100: blockterm.TerminalService.StreamCommand:output_type -> blockterm.RunCommandEvent
+	15, // 101: blockterm.TerminalService.CreateBroadcastGroup:output_type -> blockterm.BroadcastGroup
+	15, // 102: blockterm.TerminalService.UpdateBroadcastGroup:output_type -> blockterm.BroadcastGroup
+	77, // 103: blockterm.TerminalService.DeleteBroadcastGroup:output_type -> blockterm.Ack
+	18, // 104: blockterm.TerminalService.ListBroadcastGroups:output_type -> blockterm.ListBroadcastGroupsResponse
+	20, // 105: blockterm.TerminalService.SendGroupInput:output_type -> blockterm.GroupInputResult
+	12, // 106: blockterm.TerminalService.ShareSession:output_type -> blockterm.ShareSessionResponse
+	77, // 107: blockterm.TerminalService.RevokeShare:output_type -> blockterm.Ack
+	10, // 108: blockterm.TerminalService.WaitForOutput:output_type -> blockterm.WaitForOutputResponse
+	52, // 109: blockterm.SuggestionService.GetSuggestions:output_type -> blockterm.GetSuggestionsResponse
+	77, // 110: blockterm.HistoryService.RecordCommand:output_type -> blockterm.Ack
+	55, // 111: blockterm.HistoryService.QueryHistory:output_type -> blockterm.QueryHistoryResponse
+	57, // 112: blockterm.RecordingService.ListRecordings:output_type -> blockterm.ListRecordingsResponse
+	77, // 113: blockterm.RecordingService.DeleteRecording:output_type -> blockterm.Ack
+	68, // 114: blockterm.PlaybackService.OpenPlayback:output_type -> blockterm.PlaybackInfo
+	7,  // 115: blockterm.PlaybackService.StreamPlayback:output_type -> blockterm.OutputChunk
+	68, // 116: blockterm.PlaybackService.ControlPlayback:output_type -> blockterm.PlaybackInfo
+	77, // 117: blockterm.PlaybackService.ClosePlayback:output_type -> blockterm.Ack
+	60, // 118: blockterm.TriggerService.CreateTrigger:output_type -> blockterm.Trigger
+	61, // 119: blockterm.TriggerService.ListTriggers:output_type -> blockterm.ListTriggersResponse
+	60, // 120: blockterm.TriggerService.GetTrigger:output_type -> blockterm.Trigger
+	60, // 121: blockterm.TriggerService.UpdateTrigger:output_type -> blockterm.Trigger
+	77, // 122: blockterm.TriggerService.DeleteTrigger:output_type -> blockterm.Ack
+	59, // 123: blockterm.ProfileService.CreateProfile:output_type -> blockterm.Profile
+	64, // 124: blockterm.ProfileService.ListProfiles:output_type -> blockterm.ListProfilesResponse
+	59, // 125: blockterm.ProfileService.GetProfile:output_type -> blockterm.Profile
+	59, // 126: blockterm.ProfileService.UpdateProfile:output_type -> blockterm.Profile
+	77, // 127: blockterm.ProfileService.DeleteProfile:output_type -> blockterm.Ack
+	77, // 128: blockterm.WorkspaceService.SaveLayout:output_type -> blockterm.Ack
+	73, // 129: blockterm.WorkspaceService.LoadLayout:output_type -> blockterm.LoadLayoutResponse
+	75, // 130: blockterm.SystemService.Ping:output_type -> blockterm.PingResponse
+	76, // 131: blockterm.SystemService.GetVersion:output_type -> blockterm.VersionResponse
+	85, // [85:132] is the sub-list for method output_type
+	38, // [38:85] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
+}
+
+func init() { file_blockterm_proto_init() }
+func file_blockterm_proto_init() {
+	if File_blockterm_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_blockterm_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*StartSessionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*StartSessionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*CloseSessionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*CloseSessionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*KilledProcess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*InputChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*ReceiveOutputRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*OutputChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*SessionExit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*WaitForOutputRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*WaitForOutputResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*ShareSessionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*ShareSessionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*RevokeShareRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*SessionShare); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*BroadcastGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateBroadcastGroupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteBroadcastGroupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*ListBroadcastGroupsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*GroupInputChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*GroupInputResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*MemberFailure); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*RunCommandRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*RunCommandResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*RunCommandEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*RunCommandOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*SessionEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*TriggerHit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*TerminalSize); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*SubscribeAllRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*SubscribeAllResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*SubscriptionEnded); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*AttachRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*AttachOpen); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*AttachResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*AttachError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*ProcessInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*SessionStateChange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1291,8 +7130,8 @@ func file_blockterm_proto_init() {
 				return nil
 			}
 		}
-		file_blockterm_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*StartSessionResponse); i {
+		file_blockterm_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*ResizeSessionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1303,8 +7142,8 @@ func file_blockterm_proto_init() {
 				return nil
 			}
 		}
-		file_blockterm_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*CloseSessionRequest); i {
+		file_blockterm_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*GetSessionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1315,8 +7154,8 @@ func file_blockterm_proto_init() {
 				return nil
 			}
 		}
-		file_blockterm_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*InputChunk); i {
+		file_blockterm_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*DetachSessionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1327,8 +7166,8 @@ func file_blockterm_proto_init() {
 				return nil
 			}
 		}
-		file_blockterm_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*ReceiveOutputRequest); i {
+		file_blockterm_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*SessionInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1339,8 +7178,8 @@ func file_blockterm_proto_init() {
 				return nil
 			}
 		}
-		file_blockterm_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*OutputChunk); i {
+		file_blockterm_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*ListSessionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1351,8 +7190,8 @@ func file_blockterm_proto_init() {
 				return nil
 			}
 		}
-		file_blockterm_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*ResizeSessionRequest); i {
+		file_blockterm_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*GetCwdHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1363,7 +7202,79 @@ func file_blockterm_proto_init() {
 				return nil
 			}
 		}
-		file_blockterm_proto_msgTypes[7].Exporter = func(v any, i int) any {
+		file_blockterm_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*CwdChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*GetCwdHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*SetRecordingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*SetRecordingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*SignalSessionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*SignalSessionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[50].Exporter = func(v any, i int) any {
 			switch v := v.(*GetSuggestionsRequest); i {
 			case 0:
 				return &v.state
@@ -1375,7 +7286,7 @@ func file_blockterm_proto_init() {
 				return nil
 			}
 		}
-		file_blockterm_proto_msgTypes[8].Exporter = func(v any, i int) any {
+		file_blockterm_proto_msgTypes[51].Exporter = func(v any, i int) any {
 			switch v := v.(*Suggestion); i {
 			case 0:
 				return &v.state
@@ -1387,7 +7298,7 @@ func file_blockterm_proto_init() {
 				return nil
 			}
 		}
-		file_blockterm_proto_msgTypes[9].Exporter = func(v any, i int) any {
+		file_blockterm_proto_msgTypes[52].Exporter = func(v any, i int) any {
 			switch v := v.(*GetSuggestionsResponse); i {
 			case 0:
 				return &v.state
@@ -1399,7 +7310,7 @@ func file_blockterm_proto_init() {
 				return nil
 			}
 		}
-		file_blockterm_proto_msgTypes[10].Exporter = func(v any, i int) any {
+		file_blockterm_proto_msgTypes[53].Exporter = func(v any, i int) any {
 			switch v := v.(*RecordCommandRequest); i {
 			case 0:
 				return &v.state
@@ -1411,7 +7322,7 @@ func file_blockterm_proto_init() {
 				return nil
 			}
 		}
-		file_blockterm_proto_msgTypes[11].Exporter = func(v any, i int) any {
+		file_blockterm_proto_msgTypes[54].Exporter = func(v any, i int) any {
 			switch v := v.(*QueryHistoryRequest); i {
 			case 0:
 				return &v.state
@@ -1423,7 +7334,7 @@ func file_blockterm_proto_init() {
 				return nil
 			}
 		}
-		file_blockterm_proto_msgTypes[12].Exporter = func(v any, i int) any {
+		file_blockterm_proto_msgTypes[55].Exporter = func(v any, i int) any {
 			switch v := v.(*QueryHistoryResponse); i {
 			case 0:
 				return &v.state
@@ -1435,7 +7346,199 @@ func file_blockterm_proto_init() {
 				return nil
 			}
 		}
-		file_blockterm_proto_msgTypes[13].Exporter = func(v any, i int) any {
+		file_blockterm_proto_msgTypes[56].Exporter = func(v any, i int) any {
+			switch v := v.(*RecordingInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[57].Exporter = func(v any, i int) any {
+			switch v := v.(*ListRecordingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[58].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteRecordingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[59].Exporter = func(v any, i int) any {
+			switch v := v.(*Profile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[60].Exporter = func(v any, i int) any {
+			switch v := v.(*Trigger); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[61].Exporter = func(v any, i int) any {
+			switch v := v.(*ListTriggersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[62].Exporter = func(v any, i int) any {
+			switch v := v.(*GetTriggerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[63].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteTriggerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[64].Exporter = func(v any, i int) any {
+			switch v := v.(*ListProfilesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[65].Exporter = func(v any, i int) any {
+			switch v := v.(*GetProfileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[66].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteProfileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[67].Exporter = func(v any, i int) any {
+			switch v := v.(*OpenPlaybackRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[68].Exporter = func(v any, i int) any {
+			switch v := v.(*PlaybackInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[69].Exporter = func(v any, i int) any {
+			switch v := v.(*StreamPlaybackRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[70].Exporter = func(v any, i int) any {
+			switch v := v.(*ControlPlaybackRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[71].Exporter = func(v any, i int) any {
+			switch v := v.(*ClosePlaybackRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockterm_proto_msgTypes[72].Exporter = func(v any, i int) any {
 			switch v := v.(*SaveLayoutRequest); i {
 			case 0:
 				return &v.state
@@ -1447,7 +7550,7 @@ func file_blockterm_proto_init() {
 				return nil
 			}
 		}
-		file_blockterm_proto_msgTypes[14].Exporter = func(v any, i int) any {
+		file_blockterm_proto_msgTypes[73].Exporter = func(v any, i int) any {
 			switch v := v.(*LoadLayoutResponse); i {
 			case 0:
 				return &v.state
@@ -1459,7 +7562,7 @@ func file_blockterm_proto_init() {
 				return nil
 			}
 		}
-		file_blockterm_proto_msgTypes[15].Exporter = func(v any, i int) any {
+		file_blockterm_proto_msgTypes[74].Exporter = func(v any, i int) any {
 			switch v := v.(*PingRequest); i {
 			case 0:
 				return &v.state
@@ -1471,7 +7574,7 @@ func file_blockterm_proto_init() {
 				return nil
 			}
 		}
-		file_blockterm_proto_msgTypes[16].Exporter = func(v any, i int) any {
+		file_blockterm_proto_msgTypes[75].Exporter = func(v any, i int) any {
 			switch v := v.(*PingResponse); i {
 			case 0:
 				return &v.state
@@ -1483,7 +7586,7 @@ func file_blockterm_proto_init() {
 				return nil
 			}
 		}
-		file_blockterm_proto_msgTypes[17].Exporter = func(v any, i int) any {
+		file_blockterm_proto_msgTypes[76].Exporter = func(v any, i int) any {
 			switch v := v.(*VersionResponse); i {
 			case 0:
 				return &v.state
@@ -1495,7 +7598,7 @@ func file_blockterm_proto_init() {
 				return nil
 			}
 		}
-		file_blockterm_proto_msgTypes[18].Exporter = func(v any, i int) any {
+		file_blockterm_proto_msgTypes[77].Exporter = func(v any, i int) any {
 			switch v := v.(*Ack); i {
 			case 0:
 				return &v.state
@@ -1508,15 +7611,36 @@ func file_blockterm_proto_init() {
 			}
 		}
 	}
+	file_blockterm_proto_msgTypes[24].OneofWrappers = []any{
+		(*RunCommandEvent_Output)(nil),
+		(*RunCommandEvent_Result)(nil),
+	}
+	file_blockterm_proto_msgTypes[30].OneofWrappers = []any{
+		(*SubscribeAllResponse_Output)(nil),
+		(*SubscribeAllResponse_Ended)(nil),
+	}
+	file_blockterm_proto_msgTypes[32].OneofWrappers = []any{
+		(*AttachRequest_Open)(nil),
+		(*AttachRequest_Input)(nil),
+		(*AttachRequest_Resize)(nil),
+		(*AttachRequest_Signal)(nil),
+		(*AttachRequest_Ack)(nil),
+	}
+	file_blockterm_proto_msgTypes[34].OneofWrappers = []any{
+		(*AttachResponse_Output)(nil),
+		(*AttachResponse_Signaled)(nil),
+		(*AttachResponse_Error)(nil),
+	}
+	file_blockterm_proto_msgTypes[53].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_blockterm_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   83,
 			NumExtensions: 0,
-			NumServices:   5,
+			NumServices:   9,
 		},
 		GoTypes:           file_blockterm_proto_goTypes,
 		DependencyIndexes: file_blockterm_proto_depIdxs,
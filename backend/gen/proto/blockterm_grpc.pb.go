@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
-// - protoc-gen-go-grpc v1.6.0
+// - protoc-gen-go-grpc v1.6.2
 // - protoc             v5.29.3
 // source: blockterm.proto
 
@@ -21,22 +21,118 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	TerminalService_StartSession_FullMethodName  = "/blockterm.TerminalService/StartSession"
-	TerminalService_CloseSession_FullMethodName  = "/blockterm.TerminalService/CloseSession"
-	TerminalService_SendInput_FullMethodName     = "/blockterm.TerminalService/SendInput"
-	TerminalService_ReceiveOutput_FullMethodName = "/blockterm.TerminalService/ReceiveOutput"
-	TerminalService_ResizeSession_FullMethodName = "/blockterm.TerminalService/ResizeSession"
+	TerminalService_StartSession_FullMethodName         = "/blockterm.TerminalService/StartSession"
+	TerminalService_CloseSession_FullMethodName         = "/blockterm.TerminalService/CloseSession"
+	TerminalService_SendInput_FullMethodName            = "/blockterm.TerminalService/SendInput"
+	TerminalService_ReceiveOutput_FullMethodName        = "/blockterm.TerminalService/ReceiveOutput"
+	TerminalService_Attach_FullMethodName               = "/blockterm.TerminalService/Attach"
+	TerminalService_SubscribeAll_FullMethodName         = "/blockterm.TerminalService/SubscribeAll"
+	TerminalService_ResizeSession_FullMethodName        = "/blockterm.TerminalService/ResizeSession"
+	TerminalService_ListSessions_FullMethodName         = "/blockterm.TerminalService/ListSessions"
+	TerminalService_GetSession_FullMethodName           = "/blockterm.TerminalService/GetSession"
+	TerminalService_DetachSession_FullMethodName        = "/blockterm.TerminalService/DetachSession"
+	TerminalService_GetCwdHistory_FullMethodName        = "/blockterm.TerminalService/GetCwdHistory"
+	TerminalService_SignalSession_FullMethodName        = "/blockterm.TerminalService/SignalSession"
+	TerminalService_SetRecording_FullMethodName         = "/blockterm.TerminalService/SetRecording"
+	TerminalService_WatchSessionEvents_FullMethodName   = "/blockterm.TerminalService/WatchSessionEvents"
+	TerminalService_RunCommand_FullMethodName           = "/blockterm.TerminalService/RunCommand"
+	TerminalService_StreamCommand_FullMethodName        = "/blockterm.TerminalService/StreamCommand"
+	TerminalService_CreateBroadcastGroup_FullMethodName = "/blockterm.TerminalService/CreateBroadcastGroup"
+	TerminalService_UpdateBroadcastGroup_FullMethodName = "/blockterm.TerminalService/UpdateBroadcastGroup"
+	TerminalService_DeleteBroadcastGroup_FullMethodName = "/blockterm.TerminalService/DeleteBroadcastGroup"
+	TerminalService_ListBroadcastGroups_FullMethodName  = "/blockterm.TerminalService/ListBroadcastGroups"
+	TerminalService_SendGroupInput_FullMethodName       = "/blockterm.TerminalService/SendGroupInput"
+	TerminalService_ShareSession_FullMethodName         = "/blockterm.TerminalService/ShareSession"
+	TerminalService_RevokeShare_FullMethodName          = "/blockterm.TerminalService/RevokeShare"
+	TerminalService_WaitForOutput_FullMethodName        = "/blockterm.TerminalService/WaitForOutput"
 )
 
 // TerminalServiceClient is the client API for TerminalService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Authentication: at startup the server writes a random server token to
+// ~/.blockterm/server-token, readable only by the user it runs as. Clients
+// send it as "blockterm-server-token" metadata. Every RPC of every service
+// requires it, failing with UNAUTHENTICATED, except the ones about a
+// particular session or broadcast group: those take the owner, share or
+// group token in the request instead (see Sharing below), so a
+// collaborator needs nothing else. Traffic is not encrypted; keep the port
+// on a network you trust.
 type TerminalServiceClient interface {
 	StartSession(ctx context.Context, in *StartSessionRequest, opts ...grpc.CallOption) (*StartSessionResponse, error)
-	CloseSession(ctx context.Context, in *CloseSessionRequest, opts ...grpc.CallOption) (*Ack, error)
+	// Hangs up the session and terminates every process it started, escalating
+	// from SIGHUP to SIGTERM to SIGKILL; see CloseSessionRequest.
+	CloseSession(ctx context.Context, in *CloseSessionRequest, opts ...grpc.CallOption) (*CloseSessionResponse, error)
 	SendInput(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[InputChunk, Ack], error)
+	// Output streams of a session whose shell ends finish with a chunk
+	// carrying `exit`.
 	ReceiveOutput(ctx context.Context, in *ReceiveOutputRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[OutputChunk], error)
+	// Attach carries a session's input, resizes and signals up and its
+	// output, block and state frames down on a single stream, replacing the
+	// SendInput/ReceiveOutput pair. The first client frame must be `open`.
+	Attach(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[AttachRequest, AttachResponse], error)
+	// SubscribeAll multiplexes the output of many sessions onto one stream,
+	// each chunk tagged with its session_id. Sessions are added and removed
+	// by sending further requests on the same stream.
+	SubscribeAll(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[SubscribeAllRequest, SubscribeAllResponse], error)
 	ResizeSession(ctx context.Context, in *ResizeSessionRequest, opts ...grpc.CallOption) (*Ack, error)
+	// Sessions outlive their clients: a client detaches by ending its
+	// ReceiveOutput stream (or via DetachSession) and re-attaches by opening a
+	// new ReceiveOutput stream, optionally resuming from an offset.
+	ListSessions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	// Returns a snapshot of one session, including its foreground process
+	// and whether it is on the alternate screen; poll it to follow those.
+	GetSession(ctx context.Context, in *GetSessionRequest, opts ...grpc.CallOption) (*SessionInfo, error)
+	DetachSession(ctx context.Context, in *DetachSessionRequest, opts ...grpc.CallOption) (*Ack, error)
+	GetCwdHistory(ctx context.Context, in *GetCwdHistoryRequest, opts ...grpc.CallOption) (*GetCwdHistoryResponse, error)
+	// Signals the terminal's foreground process group (the running job, or
+	// the shell itself at a prompt).
+	SignalSession(ctx context.Context, in *SignalSessionRequest, opts ...grpc.CallOption) (*SignalSessionResponse, error)
+	// Starts or stops asciicast recording of a running session.
+	SetRecording(ctx context.Context, in *SetRecordingRequest, opts ...grpc.CallOption) (*SetRecordingResponse, error)
+	// Streams idle/lifetime limit warnings and reaps, and matches of "event"
+	// triggers, for every session, so a client can warn about detached
+	// sessions it is not streaming. Starting a session beyond the session
+	// limit fails with RESOURCE_EXHAUSTED.
+	WatchSessionEvents(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SessionEvent], error)
+	// Runs a command line without a visible terminal, through the user's
+	// shell so startup files, aliases and PATH match a session. stdout and
+	// stderr are captured separately, up to a cap each.
+	RunCommand(ctx context.Context, in *RunCommandRequest, opts ...grpc.CallOption) (*RunCommandResult, error)
+	// Like RunCommand, but streams output as it arrives and ends with the
+	// result.
+	StreamCommand(ctx context.Context, in *RunCommandRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[RunCommandEvent], error)
+	// Broadcast groups: input sent to a group is written to every member
+	// session, for typing into several panes at once. Members are added with
+	// a token that may write to them, which the input is written with, so a
+	// member whose share is revoked stops taking it. Updating, deleting and
+	// sending input to a group take the group_token CreateBroadcastGroup
+	// returned. Sessions leave their groups when they end.
+	CreateBroadcastGroup(ctx context.Context, in *CreateBroadcastGroupRequest, opts ...grpc.CallOption) (*BroadcastGroup, error)
+	UpdateBroadcastGroup(ctx context.Context, in *BroadcastGroup, opts ...grpc.CallOption) (*BroadcastGroup, error)
+	DeleteBroadcastGroup(ctx context.Context, in *DeleteBroadcastGroupRequest, opts ...grpc.CallOption) (*Ack, error)
+	ListBroadcastGroups(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListBroadcastGroupsResponse, error)
+	// Every chunk is queued for each member and answered with the members
+	// it could not be queued for, such as one whose terminal has not taken
+	// earlier input yet; unlike SendInput, a failed member does not end the
+	// stream.
+	SendGroupInput(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[GroupInputChunk, GroupInputResult], error)
+	// Sharing: every request about a session carries a share_token, either
+	// the owner_token StartSession returned or a share token the owner
+	// created for a collaborator; requests without a valid one get
+	// PERMISSION_DENIED. Only the owner may share, revoke, record, detach or
+	// close a session. Read-only viewers get PERMISSION_DENIED for anything
+	// that changes the session. The terminal takes the smallest size among
+	// the owner and the interactive viewers with a stream open.
+	ShareSession(ctx context.Context, in *ShareSessionRequest, opts ...grpc.CallOption) (*ShareSessionResponse, error)
+	// Invalidates share tokens and ends the streams opened with them.
+	RevokeShare(ctx context.Context, in *RevokeShareRequest, opts ...grpc.CallOption) (*Ack, error)
+	// Blocks until the session's output (markers stripped) matches a regex or
+	// literal, a command block completes, or the timeout elapses, for
+	// expect-style scripting together with SendInput. Pass the previous
+	// end_offset as from_offset to wait for what comes next.
+	WaitForOutput(ctx context.Context, in *WaitForOutputRequest, opts ...grpc.CallOption) (*WaitForOutputResponse, error)
 }
 
 type terminalServiceClient struct {
@@ -57,9 +153,9 @@ func (c *terminalServiceClient) StartSession(ctx context.Context, in *StartSessi
 	return out, nil
 }
 
-func (c *terminalServiceClient) CloseSession(ctx context.Context, in *CloseSessionRequest, opts ...grpc.CallOption) (*Ack, error) {
+func (c *terminalServiceClient) CloseSession(ctx context.Context, in *CloseSessionRequest, opts ...grpc.CallOption) (*CloseSessionResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Ack)
+	out := new(CloseSessionResponse)
 	err := c.cc.Invoke(ctx, TerminalService_CloseSession_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -99,6 +195,32 @@ func (c *terminalServiceClient) ReceiveOutput(ctx context.Context, in *ReceiveOu
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type TerminalService_ReceiveOutputClient = grpc.ServerStreamingClient[OutputChunk]
 
+func (c *terminalServiceClient) Attach(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[AttachRequest, AttachResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TerminalService_ServiceDesc.Streams[2], TerminalService_Attach_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[AttachRequest, AttachResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TerminalService_AttachClient = grpc.BidiStreamingClient[AttachRequest, AttachResponse]
+
+func (c *terminalServiceClient) SubscribeAll(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[SubscribeAllRequest, SubscribeAllResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TerminalService_ServiceDesc.Streams[3], TerminalService_SubscribeAll_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribeAllRequest, SubscribeAllResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TerminalService_SubscribeAllClient = grpc.BidiStreamingClient[SubscribeAllRequest, SubscribeAllResponse]
+
 func (c *terminalServiceClient) ResizeSession(ctx context.Context, in *ResizeSessionRequest, opts ...grpc.CallOption) (*Ack, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Ack)
@@ -109,15 +231,283 @@ func (c *terminalServiceClient) ResizeSession(ctx context.Context, in *ResizeSes
 	return out, nil
 }
 
+func (c *terminalServiceClient) ListSessions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, TerminalService_ListSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *terminalServiceClient) GetSession(ctx context.Context, in *GetSessionRequest, opts ...grpc.CallOption) (*SessionInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SessionInfo)
+	err := c.cc.Invoke(ctx, TerminalService_GetSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *terminalServiceClient) DetachSession(ctx context.Context, in *DetachSessionRequest, opts ...grpc.CallOption) (*Ack, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Ack)
+	err := c.cc.Invoke(ctx, TerminalService_DetachSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *terminalServiceClient) GetCwdHistory(ctx context.Context, in *GetCwdHistoryRequest, opts ...grpc.CallOption) (*GetCwdHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCwdHistoryResponse)
+	err := c.cc.Invoke(ctx, TerminalService_GetCwdHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *terminalServiceClient) SignalSession(ctx context.Context, in *SignalSessionRequest, opts ...grpc.CallOption) (*SignalSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SignalSessionResponse)
+	err := c.cc.Invoke(ctx, TerminalService_SignalSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *terminalServiceClient) SetRecording(ctx context.Context, in *SetRecordingRequest, opts ...grpc.CallOption) (*SetRecordingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetRecordingResponse)
+	err := c.cc.Invoke(ctx, TerminalService_SetRecording_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *terminalServiceClient) WatchSessionEvents(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SessionEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TerminalService_ServiceDesc.Streams[4], TerminalService_WatchSessionEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[emptypb.Empty, SessionEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TerminalService_WatchSessionEventsClient = grpc.ServerStreamingClient[SessionEvent]
+
+func (c *terminalServiceClient) RunCommand(ctx context.Context, in *RunCommandRequest, opts ...grpc.CallOption) (*RunCommandResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RunCommandResult)
+	err := c.cc.Invoke(ctx, TerminalService_RunCommand_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *terminalServiceClient) StreamCommand(ctx context.Context, in *RunCommandRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[RunCommandEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TerminalService_ServiceDesc.Streams[5], TerminalService_StreamCommand_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[RunCommandRequest, RunCommandEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TerminalService_StreamCommandClient = grpc.ServerStreamingClient[RunCommandEvent]
+
+func (c *terminalServiceClient) CreateBroadcastGroup(ctx context.Context, in *CreateBroadcastGroupRequest, opts ...grpc.CallOption) (*BroadcastGroup, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BroadcastGroup)
+	err := c.cc.Invoke(ctx, TerminalService_CreateBroadcastGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *terminalServiceClient) UpdateBroadcastGroup(ctx context.Context, in *BroadcastGroup, opts ...grpc.CallOption) (*BroadcastGroup, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BroadcastGroup)
+	err := c.cc.Invoke(ctx, TerminalService_UpdateBroadcastGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *terminalServiceClient) DeleteBroadcastGroup(ctx context.Context, in *DeleteBroadcastGroupRequest, opts ...grpc.CallOption) (*Ack, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Ack)
+	err := c.cc.Invoke(ctx, TerminalService_DeleteBroadcastGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *terminalServiceClient) ListBroadcastGroups(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListBroadcastGroupsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBroadcastGroupsResponse)
+	err := c.cc.Invoke(ctx, TerminalService_ListBroadcastGroups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *terminalServiceClient) SendGroupInput(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[GroupInputChunk, GroupInputResult], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TerminalService_ServiceDesc.Streams[6], TerminalService_SendGroupInput_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GroupInputChunk, GroupInputResult]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TerminalService_SendGroupInputClient = grpc.BidiStreamingClient[GroupInputChunk, GroupInputResult]
+
+func (c *terminalServiceClient) ShareSession(ctx context.Context, in *ShareSessionRequest, opts ...grpc.CallOption) (*ShareSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShareSessionResponse)
+	err := c.cc.Invoke(ctx, TerminalService_ShareSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *terminalServiceClient) RevokeShare(ctx context.Context, in *RevokeShareRequest, opts ...grpc.CallOption) (*Ack, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Ack)
+	err := c.cc.Invoke(ctx, TerminalService_RevokeShare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *terminalServiceClient) WaitForOutput(ctx context.Context, in *WaitForOutputRequest, opts ...grpc.CallOption) (*WaitForOutputResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WaitForOutputResponse)
+	err := c.cc.Invoke(ctx, TerminalService_WaitForOutput_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TerminalServiceServer is the server API for TerminalService service.
 // All implementations must embed UnimplementedTerminalServiceServer
 // for forward compatibility.
+//
+// Authentication: at startup the server writes a random server token to
+// ~/.blockterm/server-token, readable only by the user it runs as. Clients
+// send it as "blockterm-server-token" metadata. Every RPC of every service
+// requires it, failing with UNAUTHENTICATED, except the ones about a
+// particular session or broadcast group: those take the owner, share or
+// group token in the request instead (see Sharing below), so a
+// collaborator needs nothing else. Traffic is not encrypted; keep the port
+// on a network you trust.
 type TerminalServiceServer interface {
 	StartSession(context.Context, *StartSessionRequest) (*StartSessionResponse, error)
-	CloseSession(context.Context, *CloseSessionRequest) (*Ack, error)
+	// Hangs up the session and terminates every process it started, escalating
+	// from SIGHUP to SIGTERM to SIGKILL; see CloseSessionRequest.
+	CloseSession(context.Context, *CloseSessionRequest) (*CloseSessionResponse, error)
 	SendInput(grpc.ClientStreamingServer[InputChunk, Ack]) error
+	// Output streams of a session whose shell ends finish with a chunk
+	// carrying `exit`.
 	ReceiveOutput(*ReceiveOutputRequest, grpc.ServerStreamingServer[OutputChunk]) error
+	// Attach carries a session's input, resizes and signals up and its
+	// output, block and state frames down on a single stream, replacing the
+	// SendInput/ReceiveOutput pair. The first client frame must be `open`.
+	Attach(grpc.BidiStreamingServer[AttachRequest, AttachResponse]) error
+	// SubscribeAll multiplexes the output of many sessions onto one stream,
+	// each chunk tagged with its session_id. Sessions are added and removed
+	// by sending further requests on the same stream.
+	SubscribeAll(grpc.BidiStreamingServer[SubscribeAllRequest, SubscribeAllResponse]) error
 	ResizeSession(context.Context, *ResizeSessionRequest) (*Ack, error)
+	// Sessions outlive their clients: a client detaches by ending its
+	// ReceiveOutput stream (or via DetachSession) and re-attaches by opening a
+	// new ReceiveOutput stream, optionally resuming from an offset.
+	ListSessions(context.Context, *emptypb.Empty) (*ListSessionsResponse, error)
+	// Returns a snapshot of one session, including its foreground process
+	// and whether it is on the alternate screen; poll it to follow those.
+	GetSession(context.Context, *GetSessionRequest) (*SessionInfo, error)
+	DetachSession(context.Context, *DetachSessionRequest) (*Ack, error)
+	GetCwdHistory(context.Context, *GetCwdHistoryRequest) (*GetCwdHistoryResponse, error)
+	// Signals the terminal's foreground process group (the running job, or
+	// the shell itself at a prompt).
+	SignalSession(context.Context, *SignalSessionRequest) (*SignalSessionResponse, error)
+	// Starts or stops asciicast recording of a running session.
+	SetRecording(context.Context, *SetRecordingRequest) (*SetRecordingResponse, error)
+	// Streams idle/lifetime limit warnings and reaps, and matches of "event"
+	// triggers, for every session, so a client can warn about detached
+	// sessions it is not streaming. Starting a session beyond the session
+	// limit fails with RESOURCE_EXHAUSTED.
+	WatchSessionEvents(*emptypb.Empty, grpc.ServerStreamingServer[SessionEvent]) error
+	// Runs a command line without a visible terminal, through the user's
+	// shell so startup files, aliases and PATH match a session. stdout and
+	// stderr are captured separately, up to a cap each.
+	RunCommand(context.Context, *RunCommandRequest) (*RunCommandResult, error)
+	// Like RunCommand, but streams output as it arrives and ends with the
+	// result.
+	StreamCommand(*RunCommandRequest, grpc.ServerStreamingServer[RunCommandEvent]) error
+	// Broadcast groups: input sent to a group is written to every member
+	// session, for typing into several panes at once. Members are added with
+	// a token that may write to them, which the input is written with, so a
+	// member whose share is revoked stops taking it. Updating, deleting and
+	// sending input to a group take the group_token CreateBroadcastGroup
+	// returned. Sessions leave their groups when they end.
+	CreateBroadcastGroup(context.Context, *CreateBroadcastGroupRequest) (*BroadcastGroup, error)
+	UpdateBroadcastGroup(context.Context, *BroadcastGroup) (*BroadcastGroup, error)
+	DeleteBroadcastGroup(context.Context, *DeleteBroadcastGroupRequest) (*Ack, error)
+	ListBroadcastGroups(context.Context, *emptypb.Empty) (*ListBroadcastGroupsResponse, error)
+	// Every chunk is queued for each member and answered with the members
+	// it could not be queued for, such as one whose terminal has not taken
+	// earlier input yet; unlike SendInput, a failed member does not end the
+	// stream.
+	SendGroupInput(grpc.BidiStreamingServer[GroupInputChunk, GroupInputResult]) error
+	// Sharing: every request about a session carries a share_token, either
+	// the owner_token StartSession returned or a share token the owner
+	// created for a collaborator; requests without a valid one get
+	// PERMISSION_DENIED. Only the owner may share, revoke, record, detach or
+	// close a session. Read-only viewers get PERMISSION_DENIED for anything
+	// that changes the session. The terminal takes the smallest size among
+	// the owner and the interactive viewers with a stream open.
+	ShareSession(context.Context, *ShareSessionRequest) (*ShareSessionResponse, error)
+	// Invalidates share tokens and ends the streams opened with them.
+	RevokeShare(context.Context, *RevokeShareRequest) (*Ack, error)
+	// Blocks until the session's output (markers stripped) matches a regex or
+	// literal, a command block completes, or the timeout elapses, for
+	// expect-style scripting together with SendInput. Pass the previous
+	// end_offset as from_offset to wait for what comes next.
+	WaitForOutput(context.Context, *WaitForOutputRequest) (*WaitForOutputResponse, error)
 	mustEmbedUnimplementedTerminalServiceServer()
 }
 
@@ -131,7 +521,7 @@ type UnimplementedTerminalServiceServer struct{}
 func (UnimplementedTerminalServiceServer) StartSession(context.Context, *StartSessionRequest) (*StartSessionResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method StartSession not implemented")
 }
-func (UnimplementedTerminalServiceServer) CloseSession(context.Context, *CloseSessionRequest) (*Ack, error) {
+func (UnimplementedTerminalServiceServer) CloseSession(context.Context, *CloseSessionRequest) (*CloseSessionResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CloseSession not implemented")
 }
 func (UnimplementedTerminalServiceServer) SendInput(grpc.ClientStreamingServer[InputChunk, Ack]) error {
@@ -140,9 +530,66 @@ func (UnimplementedTerminalServiceServer) SendInput(grpc.ClientStreamingServer[I
 func (UnimplementedTerminalServiceServer) ReceiveOutput(*ReceiveOutputRequest, grpc.ServerStreamingServer[OutputChunk]) error {
 	return status.Error(codes.Unimplemented, "method ReceiveOutput not implemented")
 }
+func (UnimplementedTerminalServiceServer) Attach(grpc.BidiStreamingServer[AttachRequest, AttachResponse]) error {
+	return status.Error(codes.Unimplemented, "method Attach not implemented")
+}
+func (UnimplementedTerminalServiceServer) SubscribeAll(grpc.BidiStreamingServer[SubscribeAllRequest, SubscribeAllResponse]) error {
+	return status.Error(codes.Unimplemented, "method SubscribeAll not implemented")
+}
 func (UnimplementedTerminalServiceServer) ResizeSession(context.Context, *ResizeSessionRequest) (*Ack, error) {
 	return nil, status.Error(codes.Unimplemented, "method ResizeSession not implemented")
 }
+func (UnimplementedTerminalServiceServer) ListSessions(context.Context, *emptypb.Empty) (*ListSessionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedTerminalServiceServer) GetSession(context.Context, *GetSessionRequest) (*SessionInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSession not implemented")
+}
+func (UnimplementedTerminalServiceServer) DetachSession(context.Context, *DetachSessionRequest) (*Ack, error) {
+	return nil, status.Error(codes.Unimplemented, "method DetachSession not implemented")
+}
+func (UnimplementedTerminalServiceServer) GetCwdHistory(context.Context, *GetCwdHistoryRequest) (*GetCwdHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCwdHistory not implemented")
+}
+func (UnimplementedTerminalServiceServer) SignalSession(context.Context, *SignalSessionRequest) (*SignalSessionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SignalSession not implemented")
+}
+func (UnimplementedTerminalServiceServer) SetRecording(context.Context, *SetRecordingRequest) (*SetRecordingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetRecording not implemented")
+}
+func (UnimplementedTerminalServiceServer) WatchSessionEvents(*emptypb.Empty, grpc.ServerStreamingServer[SessionEvent]) error {
+	return status.Error(codes.Unimplemented, "method WatchSessionEvents not implemented")
+}
+func (UnimplementedTerminalServiceServer) RunCommand(context.Context, *RunCommandRequest) (*RunCommandResult, error) {
+	return nil, status.Error(codes.Unimplemented, "method RunCommand not implemented")
+}
+func (UnimplementedTerminalServiceServer) StreamCommand(*RunCommandRequest, grpc.ServerStreamingServer[RunCommandEvent]) error {
+	return status.Error(codes.Unimplemented, "method StreamCommand not implemented")
+}
+func (UnimplementedTerminalServiceServer) CreateBroadcastGroup(context.Context, *CreateBroadcastGroupRequest) (*BroadcastGroup, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateBroadcastGroup not implemented")
+}
+func (UnimplementedTerminalServiceServer) UpdateBroadcastGroup(context.Context, *BroadcastGroup) (*BroadcastGroup, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateBroadcastGroup not implemented")
+}
+func (UnimplementedTerminalServiceServer) DeleteBroadcastGroup(context.Context, *DeleteBroadcastGroupRequest) (*Ack, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteBroadcastGroup not implemented")
+}
+func (UnimplementedTerminalServiceServer) ListBroadcastGroups(context.Context, *emptypb.Empty) (*ListBroadcastGroupsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListBroadcastGroups not implemented")
+}
+func (UnimplementedTerminalServiceServer) SendGroupInput(grpc.BidiStreamingServer[GroupInputChunk, GroupInputResult]) error {
+	return status.Error(codes.Unimplemented, "method SendGroupInput not implemented")
+}
+func (UnimplementedTerminalServiceServer) ShareSession(context.Context, *ShareSessionRequest) (*ShareSessionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ShareSession not implemented")
+}
+func (UnimplementedTerminalServiceServer) RevokeShare(context.Context, *RevokeShareRequest) (*Ack, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeShare not implemented")
+}
+func (UnimplementedTerminalServiceServer) WaitForOutput(context.Context, *WaitForOutputRequest) (*WaitForOutputResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method WaitForOutput not implemented")
+}
 func (UnimplementedTerminalServiceServer) mustEmbedUnimplementedTerminalServiceServer() {}
 func (UnimplementedTerminalServiceServer) testEmbeddedByValue()                         {}
 
@@ -218,6 +665,20 @@ func _TerminalService_ReceiveOutput_Handler(srv interface{}, stream grpc.ServerS
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type TerminalService_ReceiveOutputServer = grpc.ServerStreamingServer[OutputChunk]
 
+func _TerminalService_Attach_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TerminalServiceServer).Attach(&grpc.GenericServerStream[AttachRequest, AttachResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TerminalService_AttachServer = grpc.BidiStreamingServer[AttachRequest, AttachResponse]
+
+func _TerminalService_SubscribeAll_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TerminalServiceServer).SubscribeAll(&grpc.GenericServerStream[SubscribeAllRequest, SubscribeAllResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TerminalService_SubscribeAllServer = grpc.BidiStreamingServer[SubscribeAllRequest, SubscribeAllResponse]
+
 func _TerminalService_ResizeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResizeSessionRequest)
 	if err := dec(in); err != nil {
@@ -236,137 +697,1292 @@ func _TerminalService_ResizeSession_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
-// TerminalService_ServiceDesc is the grpc.ServiceDesc for TerminalService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var TerminalService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "blockterm.TerminalService",
-	HandlerType: (*TerminalServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "StartSession",
-			Handler:    _TerminalService_StartSession_Handler,
-		},
-		{
-			MethodName: "CloseSession",
-			Handler:    _TerminalService_CloseSession_Handler,
-		},
-		{
-			MethodName: "ResizeSession",
-			Handler:    _TerminalService_ResizeSession_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "SendInput",
-			Handler:       _TerminalService_SendInput_Handler,
-			ClientStreams: true,
-		},
-		{
-			StreamName:    "ReceiveOutput",
-			Handler:       _TerminalService_ReceiveOutput_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "blockterm.proto",
+func _TerminalService_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TerminalServiceServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TerminalService_ListSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TerminalServiceServer).ListSessions(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-const (
-	SuggestionService_GetSuggestions_FullMethodName = "/blockterm.SuggestionService/GetSuggestions"
-)
-
-// SuggestionServiceClient is the client API for SuggestionService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type SuggestionServiceClient interface {
-	GetSuggestions(ctx context.Context, in *GetSuggestionsRequest, opts ...grpc.CallOption) (*GetSuggestionsResponse, error)
+func _TerminalService_GetSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TerminalServiceServer).GetSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TerminalService_GetSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TerminalServiceServer).GetSession(ctx, req.(*GetSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type suggestionServiceClient struct {
-	cc grpc.ClientConnInterface
-}
+func _TerminalService_DetachSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetachSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TerminalServiceServer).DetachSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TerminalService_DetachSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TerminalServiceServer).DetachSession(ctx, req.(*DetachSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TerminalService_GetCwdHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCwdHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TerminalServiceServer).GetCwdHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TerminalService_GetCwdHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TerminalServiceServer).GetCwdHistory(ctx, req.(*GetCwdHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TerminalService_SignalSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignalSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TerminalServiceServer).SignalSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TerminalService_SignalSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TerminalServiceServer).SignalSession(ctx, req.(*SignalSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TerminalService_SetRecording_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRecordingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TerminalServiceServer).SetRecording(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TerminalService_SetRecording_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TerminalServiceServer).SetRecording(ctx, req.(*SetRecordingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TerminalService_WatchSessionEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TerminalServiceServer).WatchSessionEvents(m, &grpc.GenericServerStream[emptypb.Empty, SessionEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TerminalService_WatchSessionEventsServer = grpc.ServerStreamingServer[SessionEvent]
+
+func _TerminalService_RunCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TerminalServiceServer).RunCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TerminalService_RunCommand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TerminalServiceServer).RunCommand(ctx, req.(*RunCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TerminalService_StreamCommand_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RunCommandRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TerminalServiceServer).StreamCommand(m, &grpc.GenericServerStream[RunCommandRequest, RunCommandEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TerminalService_StreamCommandServer = grpc.ServerStreamingServer[RunCommandEvent]
+
+func _TerminalService_CreateBroadcastGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBroadcastGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TerminalServiceServer).CreateBroadcastGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TerminalService_CreateBroadcastGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TerminalServiceServer).CreateBroadcastGroup(ctx, req.(*CreateBroadcastGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TerminalService_UpdateBroadcastGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BroadcastGroup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TerminalServiceServer).UpdateBroadcastGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TerminalService_UpdateBroadcastGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TerminalServiceServer).UpdateBroadcastGroup(ctx, req.(*BroadcastGroup))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TerminalService_DeleteBroadcastGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBroadcastGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TerminalServiceServer).DeleteBroadcastGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TerminalService_DeleteBroadcastGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TerminalServiceServer).DeleteBroadcastGroup(ctx, req.(*DeleteBroadcastGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TerminalService_ListBroadcastGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TerminalServiceServer).ListBroadcastGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TerminalService_ListBroadcastGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TerminalServiceServer).ListBroadcastGroups(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TerminalService_SendGroupInput_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TerminalServiceServer).SendGroupInput(&grpc.GenericServerStream[GroupInputChunk, GroupInputResult]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TerminalService_SendGroupInputServer = grpc.BidiStreamingServer[GroupInputChunk, GroupInputResult]
+
+func _TerminalService_ShareSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TerminalServiceServer).ShareSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TerminalService_ShareSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TerminalServiceServer).ShareSession(ctx, req.(*ShareSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TerminalService_RevokeShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TerminalServiceServer).RevokeShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TerminalService_RevokeShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TerminalServiceServer).RevokeShare(ctx, req.(*RevokeShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TerminalService_WaitForOutput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitForOutputRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TerminalServiceServer).WaitForOutput(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TerminalService_WaitForOutput_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TerminalServiceServer).WaitForOutput(ctx, req.(*WaitForOutputRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TerminalService_ServiceDesc is the grpc.ServiceDesc for TerminalService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TerminalService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "blockterm.TerminalService",
+	HandlerType: (*TerminalServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StartSession",
+			Handler:    _TerminalService_StartSession_Handler,
+		},
+		{
+			MethodName: "CloseSession",
+			Handler:    _TerminalService_CloseSession_Handler,
+		},
+		{
+			MethodName: "ResizeSession",
+			Handler:    _TerminalService_ResizeSession_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _TerminalService_ListSessions_Handler,
+		},
+		{
+			MethodName: "GetSession",
+			Handler:    _TerminalService_GetSession_Handler,
+		},
+		{
+			MethodName: "DetachSession",
+			Handler:    _TerminalService_DetachSession_Handler,
+		},
+		{
+			MethodName: "GetCwdHistory",
+			Handler:    _TerminalService_GetCwdHistory_Handler,
+		},
+		{
+			MethodName: "SignalSession",
+			Handler:    _TerminalService_SignalSession_Handler,
+		},
+		{
+			MethodName: "SetRecording",
+			Handler:    _TerminalService_SetRecording_Handler,
+		},
+		{
+			MethodName: "RunCommand",
+			Handler:    _TerminalService_RunCommand_Handler,
+		},
+		{
+			MethodName: "CreateBroadcastGroup",
+			Handler:    _TerminalService_CreateBroadcastGroup_Handler,
+		},
+		{
+			MethodName: "UpdateBroadcastGroup",
+			Handler:    _TerminalService_UpdateBroadcastGroup_Handler,
+		},
+		{
+			MethodName: "DeleteBroadcastGroup",
+			Handler:    _TerminalService_DeleteBroadcastGroup_Handler,
+		},
+		{
+			MethodName: "ListBroadcastGroups",
+			Handler:    _TerminalService_ListBroadcastGroups_Handler,
+		},
+		{
+			MethodName: "ShareSession",
+			Handler:    _TerminalService_ShareSession_Handler,
+		},
+		{
+			MethodName: "RevokeShare",
+			Handler:    _TerminalService_RevokeShare_Handler,
+		},
+		{
+			MethodName: "WaitForOutput",
+			Handler:    _TerminalService_WaitForOutput_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SendInput",
+			Handler:       _TerminalService_SendInput_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ReceiveOutput",
+			Handler:       _TerminalService_ReceiveOutput_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Attach",
+			Handler:       _TerminalService_Attach_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "SubscribeAll",
+			Handler:       _TerminalService_SubscribeAll_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WatchSessionEvents",
+			Handler:       _TerminalService_WatchSessionEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamCommand",
+			Handler:       _TerminalService_StreamCommand_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SendGroupInput",
+			Handler:       _TerminalService_SendGroupInput_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "blockterm.proto",
+}
+
+const (
+	SuggestionService_GetSuggestions_FullMethodName = "/blockterm.SuggestionService/GetSuggestions"
+)
+
+// SuggestionServiceClient is the client API for SuggestionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SuggestionServiceClient interface {
+	GetSuggestions(ctx context.Context, in *GetSuggestionsRequest, opts ...grpc.CallOption) (*GetSuggestionsResponse, error)
+}
+
+type suggestionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSuggestionServiceClient(cc grpc.ClientConnInterface) SuggestionServiceClient {
+	return &suggestionServiceClient{cc}
+}
+
+func (c *suggestionServiceClient) GetSuggestions(ctx context.Context, in *GetSuggestionsRequest, opts ...grpc.CallOption) (*GetSuggestionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSuggestionsResponse)
+	err := c.cc.Invoke(ctx, SuggestionService_GetSuggestions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SuggestionServiceServer is the server API for SuggestionService service.
+// All implementations must embed UnimplementedSuggestionServiceServer
+// for forward compatibility.
+type SuggestionServiceServer interface {
+	GetSuggestions(context.Context, *GetSuggestionsRequest) (*GetSuggestionsResponse, error)
+	mustEmbedUnimplementedSuggestionServiceServer()
+}
+
+// UnimplementedSuggestionServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSuggestionServiceServer struct{}
+
+func (UnimplementedSuggestionServiceServer) GetSuggestions(context.Context, *GetSuggestionsRequest) (*GetSuggestionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSuggestions not implemented")
+}
+func (UnimplementedSuggestionServiceServer) mustEmbedUnimplementedSuggestionServiceServer() {}
+func (UnimplementedSuggestionServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeSuggestionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SuggestionServiceServer will
+// result in compilation errors.
+type UnsafeSuggestionServiceServer interface {
+	mustEmbedUnimplementedSuggestionServiceServer()
+}
+
+func RegisterSuggestionServiceServer(s grpc.ServiceRegistrar, srv SuggestionServiceServer) {
+	// If the following call panics, it indicates UnimplementedSuggestionServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SuggestionService_ServiceDesc, srv)
+}
+
+func _SuggestionService_GetSuggestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSuggestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuggestionServiceServer).GetSuggestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SuggestionService_GetSuggestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuggestionServiceServer).GetSuggestions(ctx, req.(*GetSuggestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SuggestionService_ServiceDesc is the grpc.ServiceDesc for SuggestionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SuggestionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "blockterm.SuggestionService",
+	HandlerType: (*SuggestionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSuggestions",
+			Handler:    _SuggestionService_GetSuggestions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "blockterm.proto",
+}
+
+const (
+	HistoryService_RecordCommand_FullMethodName = "/blockterm.HistoryService/RecordCommand"
+	HistoryService_QueryHistory_FullMethodName  = "/blockterm.HistoryService/QueryHistory"
+)
+
+// HistoryServiceClient is the client API for HistoryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type HistoryServiceClient interface {
+	RecordCommand(ctx context.Context, in *RecordCommandRequest, opts ...grpc.CallOption) (*Ack, error)
+	QueryHistory(ctx context.Context, in *QueryHistoryRequest, opts ...grpc.CallOption) (*QueryHistoryResponse, error)
+}
+
+type historyServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewHistoryServiceClient(cc grpc.ClientConnInterface) HistoryServiceClient {
+	return &historyServiceClient{cc}
+}
+
+func (c *historyServiceClient) RecordCommand(ctx context.Context, in *RecordCommandRequest, opts ...grpc.CallOption) (*Ack, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Ack)
+	err := c.cc.Invoke(ctx, HistoryService_RecordCommand_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) QueryHistory(ctx context.Context, in *QueryHistoryRequest, opts ...grpc.CallOption) (*QueryHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryHistoryResponse)
+	err := c.cc.Invoke(ctx, HistoryService_QueryHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// HistoryServiceServer is the server API for HistoryService service.
+// All implementations must embed UnimplementedHistoryServiceServer
+// for forward compatibility.
+type HistoryServiceServer interface {
+	RecordCommand(context.Context, *RecordCommandRequest) (*Ack, error)
+	QueryHistory(context.Context, *QueryHistoryRequest) (*QueryHistoryResponse, error)
+	mustEmbedUnimplementedHistoryServiceServer()
+}
+
+// UnimplementedHistoryServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedHistoryServiceServer struct{}
+
+func (UnimplementedHistoryServiceServer) RecordCommand(context.Context, *RecordCommandRequest) (*Ack, error) {
+	return nil, status.Error(codes.Unimplemented, "method RecordCommand not implemented")
+}
+func (UnimplementedHistoryServiceServer) QueryHistory(context.Context, *QueryHistoryRequest) (*QueryHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method QueryHistory not implemented")
+}
+func (UnimplementedHistoryServiceServer) mustEmbedUnimplementedHistoryServiceServer() {}
+func (UnimplementedHistoryServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeHistoryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to HistoryServiceServer will
+// result in compilation errors.
+type UnsafeHistoryServiceServer interface {
+	mustEmbedUnimplementedHistoryServiceServer()
+}
+
+func RegisterHistoryServiceServer(s grpc.ServiceRegistrar, srv HistoryServiceServer) {
+	// If the following call panics, it indicates UnimplementedHistoryServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&HistoryService_ServiceDesc, srv)
+}
+
+func _HistoryService_RecordCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).RecordCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_RecordCommand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).RecordCommand(ctx, req.(*RecordCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_QueryHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).QueryHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_QueryHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).QueryHistory(ctx, req.(*QueryHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// HistoryService_ServiceDesc is the grpc.ServiceDesc for HistoryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var HistoryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "blockterm.HistoryService",
+	HandlerType: (*HistoryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RecordCommand",
+			Handler:    _HistoryService_RecordCommand_Handler,
+		},
+		{
+			MethodName: "QueryHistory",
+			Handler:    _HistoryService_QueryHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "blockterm.proto",
+}
+
+const (
+	RecordingService_ListRecordings_FullMethodName  = "/blockterm.RecordingService/ListRecordings"
+	RecordingService_DeleteRecording_FullMethodName = "/blockterm.RecordingService/DeleteRecording"
+)
+
+// RecordingServiceClient is the client API for RecordingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Recordings are asciicast v2 files under ~/.blockterm/recordings.
+type RecordingServiceClient interface {
+	ListRecordings(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListRecordingsResponse, error)
+	DeleteRecording(ctx context.Context, in *DeleteRecordingRequest, opts ...grpc.CallOption) (*Ack, error)
+}
+
+type recordingServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRecordingServiceClient(cc grpc.ClientConnInterface) RecordingServiceClient {
+	return &recordingServiceClient{cc}
+}
+
+func (c *recordingServiceClient) ListRecordings(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListRecordingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRecordingsResponse)
+	err := c.cc.Invoke(ctx, RecordingService_ListRecordings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recordingServiceClient) DeleteRecording(ctx context.Context, in *DeleteRecordingRequest, opts ...grpc.CallOption) (*Ack, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Ack)
+	err := c.cc.Invoke(ctx, RecordingService_DeleteRecording_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RecordingServiceServer is the server API for RecordingService service.
+// All implementations must embed UnimplementedRecordingServiceServer
+// for forward compatibility.
+//
+// Recordings are asciicast v2 files under ~/.blockterm/recordings.
+type RecordingServiceServer interface {
+	ListRecordings(context.Context, *emptypb.Empty) (*ListRecordingsResponse, error)
+	DeleteRecording(context.Context, *DeleteRecordingRequest) (*Ack, error)
+	mustEmbedUnimplementedRecordingServiceServer()
+}
+
+// UnimplementedRecordingServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRecordingServiceServer struct{}
+
+func (UnimplementedRecordingServiceServer) ListRecordings(context.Context, *emptypb.Empty) (*ListRecordingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRecordings not implemented")
+}
+func (UnimplementedRecordingServiceServer) DeleteRecording(context.Context, *DeleteRecordingRequest) (*Ack, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteRecording not implemented")
+}
+func (UnimplementedRecordingServiceServer) mustEmbedUnimplementedRecordingServiceServer() {}
+func (UnimplementedRecordingServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeRecordingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RecordingServiceServer will
+// result in compilation errors.
+type UnsafeRecordingServiceServer interface {
+	mustEmbedUnimplementedRecordingServiceServer()
+}
+
+func RegisterRecordingServiceServer(s grpc.ServiceRegistrar, srv RecordingServiceServer) {
+	// If the following call panics, it indicates UnimplementedRecordingServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RecordingService_ServiceDesc, srv)
+}
+
+func _RecordingService_ListRecordings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecordingServiceServer).ListRecordings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecordingService_ListRecordings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecordingServiceServer).ListRecordings(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecordingService_DeleteRecording_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRecordingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecordingServiceServer).DeleteRecording(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecordingService_DeleteRecording_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecordingServiceServer).DeleteRecording(ctx, req.(*DeleteRecordingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RecordingService_ServiceDesc is the grpc.ServiceDesc for RecordingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RecordingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "blockterm.RecordingService",
+	HandlerType: (*RecordingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListRecordings",
+			Handler:    _RecordingService_ListRecordings_Handler,
+		},
+		{
+			MethodName: "DeleteRecording",
+			Handler:    _RecordingService_DeleteRecording_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "blockterm.proto",
+}
+
+const (
+	PlaybackService_OpenPlayback_FullMethodName    = "/blockterm.PlaybackService/OpenPlayback"
+	PlaybackService_StreamPlayback_FullMethodName  = "/blockterm.PlaybackService/StreamPlayback"
+	PlaybackService_ControlPlayback_FullMethodName = "/blockterm.PlaybackService/ControlPlayback"
+	PlaybackService_ClosePlayback_FullMethodName   = "/blockterm.PlaybackService/ClosePlayback"
+)
+
+// PlaybackServiceClient is the client API for PlaybackService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Plays an asciicast v2 file back as the same OutputChunk stream a live
+// session produces, so terminal views can render it unchanged. A playback
+// is opened, streamed once, and controlled while streaming. A playback not
+// streamed within a minute of opening is discarded. The stream stays open
+// after the last frame, so the viewer can still seek back, until the client
+// cancels it or calls ClosePlayback.
+type PlaybackServiceClient interface {
+	OpenPlayback(ctx context.Context, in *OpenPlaybackRequest, opts ...grpc.CallOption) (*PlaybackInfo, error)
+	StreamPlayback(ctx context.Context, in *StreamPlaybackRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[OutputChunk], error)
+	ControlPlayback(ctx context.Context, in *ControlPlaybackRequest, opts ...grpc.CallOption) (*PlaybackInfo, error)
+	ClosePlayback(ctx context.Context, in *ClosePlaybackRequest, opts ...grpc.CallOption) (*Ack, error)
+}
+
+type playbackServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPlaybackServiceClient(cc grpc.ClientConnInterface) PlaybackServiceClient {
+	return &playbackServiceClient{cc}
+}
+
+func (c *playbackServiceClient) OpenPlayback(ctx context.Context, in *OpenPlaybackRequest, opts ...grpc.CallOption) (*PlaybackInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlaybackInfo)
+	err := c.cc.Invoke(ctx, PlaybackService_OpenPlayback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playbackServiceClient) StreamPlayback(ctx context.Context, in *StreamPlaybackRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[OutputChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PlaybackService_ServiceDesc.Streams[0], PlaybackService_StreamPlayback_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamPlaybackRequest, OutputChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PlaybackService_StreamPlaybackClient = grpc.ServerStreamingClient[OutputChunk]
+
+func (c *playbackServiceClient) ControlPlayback(ctx context.Context, in *ControlPlaybackRequest, opts ...grpc.CallOption) (*PlaybackInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlaybackInfo)
+	err := c.cc.Invoke(ctx, PlaybackService_ControlPlayback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playbackServiceClient) ClosePlayback(ctx context.Context, in *ClosePlaybackRequest, opts ...grpc.CallOption) (*Ack, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Ack)
+	err := c.cc.Invoke(ctx, PlaybackService_ClosePlayback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PlaybackServiceServer is the server API for PlaybackService service.
+// All implementations must embed UnimplementedPlaybackServiceServer
+// for forward compatibility.
+//
+// Plays an asciicast v2 file back as the same OutputChunk stream a live
+// session produces, so terminal views can render it unchanged. A playback
+// is opened, streamed once, and controlled while streaming. A playback not
+// streamed within a minute of opening is discarded. The stream stays open
+// after the last frame, so the viewer can still seek back, until the client
+// cancels it or calls ClosePlayback.
+type PlaybackServiceServer interface {
+	OpenPlayback(context.Context, *OpenPlaybackRequest) (*PlaybackInfo, error)
+	StreamPlayback(*StreamPlaybackRequest, grpc.ServerStreamingServer[OutputChunk]) error
+	ControlPlayback(context.Context, *ControlPlaybackRequest) (*PlaybackInfo, error)
+	ClosePlayback(context.Context, *ClosePlaybackRequest) (*Ack, error)
+	mustEmbedUnimplementedPlaybackServiceServer()
+}
+
+// UnimplementedPlaybackServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPlaybackServiceServer struct{}
+
+func (UnimplementedPlaybackServiceServer) OpenPlayback(context.Context, *OpenPlaybackRequest) (*PlaybackInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method OpenPlayback not implemented")
+}
+func (UnimplementedPlaybackServiceServer) StreamPlayback(*StreamPlaybackRequest, grpc.ServerStreamingServer[OutputChunk]) error {
+	return status.Error(codes.Unimplemented, "method StreamPlayback not implemented")
+}
+func (UnimplementedPlaybackServiceServer) ControlPlayback(context.Context, *ControlPlaybackRequest) (*PlaybackInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method ControlPlayback not implemented")
+}
+func (UnimplementedPlaybackServiceServer) ClosePlayback(context.Context, *ClosePlaybackRequest) (*Ack, error) {
+	return nil, status.Error(codes.Unimplemented, "method ClosePlayback not implemented")
+}
+func (UnimplementedPlaybackServiceServer) mustEmbedUnimplementedPlaybackServiceServer() {}
+func (UnimplementedPlaybackServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafePlaybackServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PlaybackServiceServer will
+// result in compilation errors.
+type UnsafePlaybackServiceServer interface {
+	mustEmbedUnimplementedPlaybackServiceServer()
+}
+
+func RegisterPlaybackServiceServer(s grpc.ServiceRegistrar, srv PlaybackServiceServer) {
+	// If the following call panics, it indicates UnimplementedPlaybackServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PlaybackService_ServiceDesc, srv)
+}
+
+func _PlaybackService_OpenPlayback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenPlaybackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaybackServiceServer).OpenPlayback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaybackService_OpenPlayback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaybackServiceServer).OpenPlayback(ctx, req.(*OpenPlaybackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaybackService_StreamPlayback_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamPlaybackRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PlaybackServiceServer).StreamPlayback(m, &grpc.GenericServerStream[StreamPlaybackRequest, OutputChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PlaybackService_StreamPlaybackServer = grpc.ServerStreamingServer[OutputChunk]
+
+func _PlaybackService_ControlPlayback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ControlPlaybackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaybackServiceServer).ControlPlayback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaybackService_ControlPlayback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaybackServiceServer).ControlPlayback(ctx, req.(*ControlPlaybackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaybackService_ClosePlayback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClosePlaybackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaybackServiceServer).ClosePlayback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaybackService_ClosePlayback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaybackServiceServer).ClosePlayback(ctx, req.(*ClosePlaybackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PlaybackService_ServiceDesc is the grpc.ServiceDesc for PlaybackService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PlaybackService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "blockterm.PlaybackService",
+	HandlerType: (*PlaybackServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "OpenPlayback",
+			Handler:    _PlaybackService_OpenPlayback_Handler,
+		},
+		{
+			MethodName: "ControlPlayback",
+			Handler:    _PlaybackService_ControlPlayback_Handler,
+		},
+		{
+			MethodName: "ClosePlayback",
+			Handler:    _PlaybackService_ClosePlayback_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamPlayback",
+			Handler:       _PlaybackService_StreamPlayback_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "blockterm.proto",
+}
+
+const (
+	TriggerService_CreateTrigger_FullMethodName = "/blockterm.TriggerService/CreateTrigger"
+	TriggerService_ListTriggers_FullMethodName  = "/blockterm.TriggerService/ListTriggers"
+	TriggerService_GetTrigger_FullMethodName    = "/blockterm.TriggerService/GetTrigger"
+	TriggerService_UpdateTrigger_FullMethodName = "/blockterm.TriggerService/UpdateTrigger"
+	TriggerService_DeleteTrigger_FullMethodName = "/blockterm.TriggerService/DeleteTrigger"
+)
+
+// TriggerServiceClient is the client API for TriggerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Rules evaluated on every session's output as it is read: when a line
+// matches a trigger's pattern, its action is taken and a chunk carrying
+// the TriggerHit follows the output on the session's streams.
+//
+// Triggers that act on a session for their creator, "send_input" and
+// "fail_block" scoped to a session, are created and updated with the
+// session's owner_token. They are kept only while the server runs: after a
+// restart they do nothing until updated with a token again.
+type TriggerServiceClient interface {
+	CreateTrigger(ctx context.Context, in *Trigger, opts ...grpc.CallOption) (*Trigger, error)
+	ListTriggers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListTriggersResponse, error)
+	GetTrigger(ctx context.Context, in *GetTriggerRequest, opts ...grpc.CallOption) (*Trigger, error)
+	UpdateTrigger(ctx context.Context, in *Trigger, opts ...grpc.CallOption) (*Trigger, error)
+	DeleteTrigger(ctx context.Context, in *DeleteTriggerRequest, opts ...grpc.CallOption) (*Ack, error)
+}
+
+type triggerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTriggerServiceClient(cc grpc.ClientConnInterface) TriggerServiceClient {
+	return &triggerServiceClient{cc}
+}
 
-func NewSuggestionServiceClient(cc grpc.ClientConnInterface) SuggestionServiceClient {
-	return &suggestionServiceClient{cc}
+func (c *triggerServiceClient) CreateTrigger(ctx context.Context, in *Trigger, opts ...grpc.CallOption) (*Trigger, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Trigger)
+	err := c.cc.Invoke(ctx, TriggerService_CreateTrigger_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (c *suggestionServiceClient) GetSuggestions(ctx context.Context, in *GetSuggestionsRequest, opts ...grpc.CallOption) (*GetSuggestionsResponse, error) {
+func (c *triggerServiceClient) ListTriggers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListTriggersResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetSuggestionsResponse)
-	err := c.cc.Invoke(ctx, SuggestionService_GetSuggestions_FullMethodName, in, out, cOpts...)
+	out := new(ListTriggersResponse)
+	err := c.cc.Invoke(ctx, TriggerService_ListTriggers_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// SuggestionServiceServer is the server API for SuggestionService service.
-// All implementations must embed UnimplementedSuggestionServiceServer
-// for forward compatibility.
-type SuggestionServiceServer interface {
-	GetSuggestions(context.Context, *GetSuggestionsRequest) (*GetSuggestionsResponse, error)
-	mustEmbedUnimplementedSuggestionServiceServer()
+func (c *triggerServiceClient) GetTrigger(ctx context.Context, in *GetTriggerRequest, opts ...grpc.CallOption) (*Trigger, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Trigger)
+	err := c.cc.Invoke(ctx, TriggerService_GetTrigger_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedSuggestionServiceServer must be embedded to have
+func (c *triggerServiceClient) UpdateTrigger(ctx context.Context, in *Trigger, opts ...grpc.CallOption) (*Trigger, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Trigger)
+	err := c.cc.Invoke(ctx, TriggerService_UpdateTrigger_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *triggerServiceClient) DeleteTrigger(ctx context.Context, in *DeleteTriggerRequest, opts ...grpc.CallOption) (*Ack, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Ack)
+	err := c.cc.Invoke(ctx, TriggerService_DeleteTrigger_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TriggerServiceServer is the server API for TriggerService service.
+// All implementations must embed UnimplementedTriggerServiceServer
+// for forward compatibility.
+//
+// Rules evaluated on every session's output as it is read: when a line
+// matches a trigger's pattern, its action is taken and a chunk carrying
+// the TriggerHit follows the output on the session's streams.
+//
+// Triggers that act on a session for their creator, "send_input" and
+// "fail_block" scoped to a session, are created and updated with the
+// session's owner_token. They are kept only while the server runs: after a
+// restart they do nothing until updated with a token again.
+type TriggerServiceServer interface {
+	CreateTrigger(context.Context, *Trigger) (*Trigger, error)
+	ListTriggers(context.Context, *emptypb.Empty) (*ListTriggersResponse, error)
+	GetTrigger(context.Context, *GetTriggerRequest) (*Trigger, error)
+	UpdateTrigger(context.Context, *Trigger) (*Trigger, error)
+	DeleteTrigger(context.Context, *DeleteTriggerRequest) (*Ack, error)
+	mustEmbedUnimplementedTriggerServiceServer()
+}
+
+// UnimplementedTriggerServiceServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedSuggestionServiceServer struct{}
+type UnimplementedTriggerServiceServer struct{}
 
-func (UnimplementedSuggestionServiceServer) GetSuggestions(context.Context, *GetSuggestionsRequest) (*GetSuggestionsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetSuggestions not implemented")
+func (UnimplementedTriggerServiceServer) CreateTrigger(context.Context, *Trigger) (*Trigger, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateTrigger not implemented")
 }
-func (UnimplementedSuggestionServiceServer) mustEmbedUnimplementedSuggestionServiceServer() {}
-func (UnimplementedSuggestionServiceServer) testEmbeddedByValue()                           {}
+func (UnimplementedTriggerServiceServer) ListTriggers(context.Context, *emptypb.Empty) (*ListTriggersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTriggers not implemented")
+}
+func (UnimplementedTriggerServiceServer) GetTrigger(context.Context, *GetTriggerRequest) (*Trigger, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTrigger not implemented")
+}
+func (UnimplementedTriggerServiceServer) UpdateTrigger(context.Context, *Trigger) (*Trigger, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateTrigger not implemented")
+}
+func (UnimplementedTriggerServiceServer) DeleteTrigger(context.Context, *DeleteTriggerRequest) (*Ack, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteTrigger not implemented")
+}
+func (UnimplementedTriggerServiceServer) mustEmbedUnimplementedTriggerServiceServer() {}
+func (UnimplementedTriggerServiceServer) testEmbeddedByValue()                        {}
 
-// UnsafeSuggestionServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to SuggestionServiceServer will
+// UnsafeTriggerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TriggerServiceServer will
 // result in compilation errors.
-type UnsafeSuggestionServiceServer interface {
-	mustEmbedUnimplementedSuggestionServiceServer()
+type UnsafeTriggerServiceServer interface {
+	mustEmbedUnimplementedTriggerServiceServer()
 }
 
-func RegisterSuggestionServiceServer(s grpc.ServiceRegistrar, srv SuggestionServiceServer) {
-	// If the following call panics, it indicates UnimplementedSuggestionServiceServer was
+func RegisterTriggerServiceServer(s grpc.ServiceRegistrar, srv TriggerServiceServer) {
+	// If the following call panics, it indicates UnimplementedTriggerServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&SuggestionService_ServiceDesc, srv)
+	s.RegisterService(&TriggerService_ServiceDesc, srv)
 }
 
-func _SuggestionService_GetSuggestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSuggestionsRequest)
+func _TriggerService_CreateTrigger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Trigger)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuggestionServiceServer).GetSuggestions(ctx, in)
+		return srv.(TriggerServiceServer).CreateTrigger(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SuggestionService_GetSuggestions_FullMethodName,
+		FullMethod: TriggerService_CreateTrigger_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuggestionServiceServer).GetSuggestions(ctx, req.(*GetSuggestionsRequest))
+		return srv.(TriggerServiceServer).CreateTrigger(ctx, req.(*Trigger))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// SuggestionService_ServiceDesc is the grpc.ServiceDesc for SuggestionService service.
+func _TriggerService_ListTriggers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggerServiceServer).ListTriggers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TriggerService_ListTriggers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggerServiceServer).ListTriggers(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TriggerService_GetTrigger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTriggerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggerServiceServer).GetTrigger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TriggerService_GetTrigger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggerServiceServer).GetTrigger(ctx, req.(*GetTriggerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TriggerService_UpdateTrigger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Trigger)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggerServiceServer).UpdateTrigger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TriggerService_UpdateTrigger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggerServiceServer).UpdateTrigger(ctx, req.(*Trigger))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TriggerService_DeleteTrigger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTriggerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggerServiceServer).DeleteTrigger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TriggerService_DeleteTrigger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggerServiceServer).DeleteTrigger(ctx, req.(*DeleteTriggerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TriggerService_ServiceDesc is the grpc.ServiceDesc for TriggerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var SuggestionService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "blockterm.SuggestionService",
-	HandlerType: (*SuggestionServiceServer)(nil),
+var TriggerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "blockterm.TriggerService",
+	HandlerType: (*TriggerServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "GetSuggestions",
-			Handler:    _SuggestionService_GetSuggestions_Handler,
+			MethodName: "CreateTrigger",
+			Handler:    _TriggerService_CreateTrigger_Handler,
+		},
+		{
+			MethodName: "ListTriggers",
+			Handler:    _TriggerService_ListTriggers_Handler,
+		},
+		{
+			MethodName: "GetTrigger",
+			Handler:    _TriggerService_GetTrigger_Handler,
+		},
+		{
+			MethodName: "UpdateTrigger",
+			Handler:    _TriggerService_UpdateTrigger_Handler,
+		},
+		{
+			MethodName: "DeleteTrigger",
+			Handler:    _TriggerService_DeleteTrigger_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -374,139 +1990,259 @@ var SuggestionService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	HistoryService_RecordCommand_FullMethodName = "/blockterm.HistoryService/RecordCommand"
-	HistoryService_QueryHistory_FullMethodName  = "/blockterm.HistoryService/QueryHistory"
+	ProfileService_CreateProfile_FullMethodName = "/blockterm.ProfileService/CreateProfile"
+	ProfileService_ListProfiles_FullMethodName  = "/blockterm.ProfileService/ListProfiles"
+	ProfileService_GetProfile_FullMethodName    = "/blockterm.ProfileService/GetProfile"
+	ProfileService_UpdateProfile_FullMethodName = "/blockterm.ProfileService/UpdateProfile"
+	ProfileService_DeleteProfile_FullMethodName = "/blockterm.ProfileService/DeleteProfile"
 )
 
-// HistoryServiceClient is the client API for HistoryService service.
+// ProfileServiceClient is the client API for ProfileService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type HistoryServiceClient interface {
-	RecordCommand(ctx context.Context, in *RecordCommandRequest, opts ...grpc.CallOption) (*Ack, error)
-	QueryHistory(ctx context.Context, in *QueryHistoryRequest, opts ...grpc.CallOption) (*QueryHistoryResponse, error)
+//
+// Named bundles of session start options, stored by the backend so every
+// client starts the same setup; see StartSessionRequest.profile_id.
+type ProfileServiceClient interface {
+	CreateProfile(ctx context.Context, in *Profile, opts ...grpc.CallOption) (*Profile, error)
+	ListProfiles(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListProfilesResponse, error)
+	GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*Profile, error)
+	UpdateProfile(ctx context.Context, in *Profile, opts ...grpc.CallOption) (*Profile, error)
+	DeleteProfile(ctx context.Context, in *DeleteProfileRequest, opts ...grpc.CallOption) (*Ack, error)
 }
 
-type historyServiceClient struct {
+type profileServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewHistoryServiceClient(cc grpc.ClientConnInterface) HistoryServiceClient {
-	return &historyServiceClient{cc}
+func NewProfileServiceClient(cc grpc.ClientConnInterface) ProfileServiceClient {
+	return &profileServiceClient{cc}
 }
 
-func (c *historyServiceClient) RecordCommand(ctx context.Context, in *RecordCommandRequest, opts ...grpc.CallOption) (*Ack, error) {
+func (c *profileServiceClient) CreateProfile(ctx context.Context, in *Profile, opts ...grpc.CallOption) (*Profile, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Ack)
-	err := c.cc.Invoke(ctx, HistoryService_RecordCommand_FullMethodName, in, out, cOpts...)
+	out := new(Profile)
+	err := c.cc.Invoke(ctx, ProfileService_CreateProfile_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *historyServiceClient) QueryHistory(ctx context.Context, in *QueryHistoryRequest, opts ...grpc.CallOption) (*QueryHistoryResponse, error) {
+func (c *profileServiceClient) ListProfiles(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListProfilesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(QueryHistoryResponse)
-	err := c.cc.Invoke(ctx, HistoryService_QueryHistory_FullMethodName, in, out, cOpts...)
+	out := new(ListProfilesResponse)
+	err := c.cc.Invoke(ctx, ProfileService_ListProfiles_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// HistoryServiceServer is the server API for HistoryService service.
-// All implementations must embed UnimplementedHistoryServiceServer
-// for forward compatibility.
-type HistoryServiceServer interface {
-	RecordCommand(context.Context, *RecordCommandRequest) (*Ack, error)
-	QueryHistory(context.Context, *QueryHistoryRequest) (*QueryHistoryResponse, error)
-	mustEmbedUnimplementedHistoryServiceServer()
+func (c *profileServiceClient) GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*Profile, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Profile)
+	err := c.cc.Invoke(ctx, ProfileService_GetProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedHistoryServiceServer must be embedded to have
+func (c *profileServiceClient) UpdateProfile(ctx context.Context, in *Profile, opts ...grpc.CallOption) (*Profile, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Profile)
+	err := c.cc.Invoke(ctx, ProfileService_UpdateProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) DeleteProfile(ctx context.Context, in *DeleteProfileRequest, opts ...grpc.CallOption) (*Ack, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Ack)
+	err := c.cc.Invoke(ctx, ProfileService_DeleteProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProfileServiceServer is the server API for ProfileService service.
+// All implementations must embed UnimplementedProfileServiceServer
+// for forward compatibility.
+//
+// Named bundles of session start options, stored by the backend so every
+// client starts the same setup; see StartSessionRequest.profile_id.
+type ProfileServiceServer interface {
+	CreateProfile(context.Context, *Profile) (*Profile, error)
+	ListProfiles(context.Context, *emptypb.Empty) (*ListProfilesResponse, error)
+	GetProfile(context.Context, *GetProfileRequest) (*Profile, error)
+	UpdateProfile(context.Context, *Profile) (*Profile, error)
+	DeleteProfile(context.Context, *DeleteProfileRequest) (*Ack, error)
+	mustEmbedUnimplementedProfileServiceServer()
+}
+
+// UnimplementedProfileServiceServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedHistoryServiceServer struct{}
+type UnimplementedProfileServiceServer struct{}
 
-func (UnimplementedHistoryServiceServer) RecordCommand(context.Context, *RecordCommandRequest) (*Ack, error) {
-	return nil, status.Error(codes.Unimplemented, "method RecordCommand not implemented")
+func (UnimplementedProfileServiceServer) CreateProfile(context.Context, *Profile) (*Profile, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateProfile not implemented")
 }
-func (UnimplementedHistoryServiceServer) QueryHistory(context.Context, *QueryHistoryRequest) (*QueryHistoryResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method QueryHistory not implemented")
+func (UnimplementedProfileServiceServer) ListProfiles(context.Context, *emptypb.Empty) (*ListProfilesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListProfiles not implemented")
 }
-func (UnimplementedHistoryServiceServer) mustEmbedUnimplementedHistoryServiceServer() {}
-func (UnimplementedHistoryServiceServer) testEmbeddedByValue()                        {}
+func (UnimplementedProfileServiceServer) GetProfile(context.Context, *GetProfileRequest) (*Profile, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetProfile not implemented")
+}
+func (UnimplementedProfileServiceServer) UpdateProfile(context.Context, *Profile) (*Profile, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateProfile not implemented")
+}
+func (UnimplementedProfileServiceServer) DeleteProfile(context.Context, *DeleteProfileRequest) (*Ack, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteProfile not implemented")
+}
+func (UnimplementedProfileServiceServer) mustEmbedUnimplementedProfileServiceServer() {}
+func (UnimplementedProfileServiceServer) testEmbeddedByValue()                        {}
 
-// UnsafeHistoryServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to HistoryServiceServer will
+// UnsafeProfileServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProfileServiceServer will
 // result in compilation errors.
-type UnsafeHistoryServiceServer interface {
-	mustEmbedUnimplementedHistoryServiceServer()
+type UnsafeProfileServiceServer interface {
+	mustEmbedUnimplementedProfileServiceServer()
 }
 
-func RegisterHistoryServiceServer(s grpc.ServiceRegistrar, srv HistoryServiceServer) {
-	// If the following call panics, it indicates UnimplementedHistoryServiceServer was
+func RegisterProfileServiceServer(s grpc.ServiceRegistrar, srv ProfileServiceServer) {
+	// If the following call panics, it indicates UnimplementedProfileServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&HistoryService_ServiceDesc, srv)
+	s.RegisterService(&ProfileService_ServiceDesc, srv)
 }
 
-func _HistoryService_RecordCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RecordCommandRequest)
+func _ProfileService_CreateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Profile)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HistoryServiceServer).RecordCommand(ctx, in)
+		return srv.(ProfileServiceServer).CreateProfile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HistoryService_RecordCommand_FullMethodName,
+		FullMethod: ProfileService_CreateProfile_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HistoryServiceServer).RecordCommand(ctx, req.(*RecordCommandRequest))
+		return srv.(ProfileServiceServer).CreateProfile(ctx, req.(*Profile))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HistoryService_QueryHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryHistoryRequest)
+func _ProfileService_ListProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HistoryServiceServer).QueryHistory(ctx, in)
+		return srv.(ProfileServiceServer).ListProfiles(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HistoryService_QueryHistory_FullMethodName,
+		FullMethod: ProfileService_ListProfiles_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HistoryServiceServer).QueryHistory(ctx, req.(*QueryHistoryRequest))
+		return srv.(ProfileServiceServer).ListProfiles(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// HistoryService_ServiceDesc is the grpc.ServiceDesc for HistoryService service.
+func _ProfileService_GetProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).GetProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_GetProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).GetProfile(ctx, req.(*GetProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Profile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).UpdateProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_UpdateProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).UpdateProfile(ctx, req.(*Profile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_DeleteProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).DeleteProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_DeleteProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).DeleteProfile(ctx, req.(*DeleteProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ProfileService_ServiceDesc is the grpc.ServiceDesc for ProfileService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var HistoryService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "blockterm.HistoryService",
-	HandlerType: (*HistoryServiceServer)(nil),
+var ProfileService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "blockterm.ProfileService",
+	HandlerType: (*ProfileServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "RecordCommand",
-			Handler:    _HistoryService_RecordCommand_Handler,
+			MethodName: "CreateProfile",
+			Handler:    _ProfileService_CreateProfile_Handler,
 		},
 		{
-			MethodName: "QueryHistory",
-			Handler:    _HistoryService_QueryHistory_Handler,
+			MethodName: "ListProfiles",
+			Handler:    _ProfileService_ListProfiles_Handler,
+		},
+		{
+			MethodName: "GetProfile",
+			Handler:    _ProfileService_GetProfile_Handler,
+		},
+		{
+			MethodName: "UpdateProfile",
+			Handler:    _ProfileService_UpdateProfile_Handler,
+		},
+		{
+			MethodName: "DeleteProfile",
+			Handler:    _ProfileService_DeleteProfile_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -24,6 +24,7 @@ type Service struct {
 type writeRequest struct {
 	cmd      *storage.Command
 	resultCh chan error // optional, for callers who want confirmation
+	idCh     chan int64 // optional, receives the row ID once inserted
 }
 
 // NewService creates a new history service with the given storage backend.
@@ -48,37 +49,18 @@ func (s *Service) writeWorker() {
 	for {
 		select {
 		case req := <-s.writeCh:
-			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-			err := s.db.InsertCommand(ctx, req.cmd)
-			cancel()
-
-			if err != nil {
+			if err := s.write(req); err != nil {
 				log.Printf("history: failed to insert command: %v", err)
 			}
 
-			// Notify caller if they're waiting for result
-			if req.resultCh != nil {
-				req.resultCh <- err
-				close(req.resultCh)
-			}
-
 		case <-s.stopCh:
 			// Drain remaining writes before exiting
 			for {
 				select {
 				case req := <-s.writeCh:
-					ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-					err := s.db.InsertCommand(ctx, req.cmd)
-					cancel()
-
-					if err != nil {
+					if err := s.write(req); err != nil {
 						log.Printf("history: failed to insert command during shutdown: %v", err)
 					}
-
-					if req.resultCh != nil {
-						req.resultCh <- err
-						close(req.resultCh)
-					}
 				default:
 					return
 				}
@@ -87,6 +69,26 @@ func (s *Service) writeWorker() {
 	}
 }
 
+// write inserts a single command and notifies any waiting caller.
+func (s *Service) write(req *writeRequest) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	err := s.db.InsertCommand(ctx, req.cmd)
+	cancel()
+
+	// Notify caller if they're waiting for result
+	if req.resultCh != nil {
+		req.resultCh <- err
+		close(req.resultCh)
+	}
+	if req.idCh != nil {
+		if err == nil {
+			req.idCh <- req.cmd.ID
+		}
+		close(req.idCh)
+	}
+	return err
+}
+
 // RecordCommand asynchronously persists a command to storage.
 // The command text is sanitized before storage.
 func (s *Service) RecordCommand(sessionID, shell, cwd, cmdText string) {
@@ -112,6 +114,63 @@ func (s *Service) RecordCommand(sessionID, shell, cwd, cmdText string) {
 	}
 }
 
+// RecordEntry asynchronously persists a command reported by a client,
+// keeping its own timestamp and exit code. A zero timestamp means now; a
+// nil exit code is stored as unknown.
+func (s *Service) RecordEntry(sessionID, shell, cwd, cmdText string, exitCode *int, ts time.Time) {
+	sanitized := sanitizeCommand(cmdText)
+	if sanitized == "" {
+		return
+	}
+	if ts.IsZero() {
+		ts = time.Now()
+	}
+
+	cmd := &storage.Command{
+		Timestamp:   ts,
+		SessionID:   sessionID,
+		Shell:       shell,
+		Cwd:         cwd,
+		CommandText: sanitized,
+		ExitCode:    exitCode,
+	}
+
+	select {
+	case s.writeCh <- &writeRequest{cmd: cmd}:
+	default:
+		log.Printf("history: write buffer full, dropping command: %s", sanitized)
+	}
+}
+
+// RecordCommandStart asynchronously persists a command as it starts
+// executing, before its exit code is known. The returned channel receives
+// the row ID once the insert succeeds and is closed afterwards; it is closed
+// without a value if the command was dropped or the insert failed. Returns
+// nil for empty commands.
+func (s *Service) RecordCommandStart(sessionID, shell, cwd, cmdText string) <-chan int64 {
+	sanitized := sanitizeCommand(cmdText)
+	if sanitized == "" {
+		return nil
+	}
+
+	cmd := &storage.Command{
+		Timestamp:   time.Now(),
+		SessionID:   sessionID,
+		Shell:       shell,
+		Cwd:         cwd,
+		CommandText: sanitized,
+	}
+
+	idCh := make(chan int64, 1)
+	select {
+	case s.writeCh <- &writeRequest{cmd: cmd, idCh: idCh}:
+	default:
+		log.Printf("history: write buffer full, dropping command: %s", sanitized)
+		close(idCh)
+	}
+	return idCh
+}
+
 // RecordCommandSync synchronously persists a command and waits for completion.
 // Returns an error if the write fails. Use sparingly.
 func (s *Service) RecordCommandSync(sessionID, shell, cwd, cmdText string) error {
@@ -139,12 +198,13 @@ func (s *Service) RecordCommandSync(sessionID, shell, cwd, cmdText string) error
 	}
 }
 
-// UpdateExitCode updates the exit code for a recently recorded command.
-// This is called after a command completes and we detect its exit status.
-func (s *Service) UpdateExitCode(cmdID int64, exitCode int) error {
+// UpdateExitCode updates the exit code and duration for a recently
+// recorded command. This is called after a command completes and we
+// detect its exit status.
+func (s *Service) UpdateExitCode(cmdID int64, exitCode int, duration time.Duration) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
 	defer cancel()
-	return s.db.UpdateExitCode(ctx, cmdID, exitCode)
+	return s.db.UpdateExitCode(ctx, cmdID, exitCode, duration)
 }
 
 // GetRecent retrieves the N most recent commands from history.
@@ -0,0 +1,167 @@
+package recording
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// asciicast v2 event types.
+const (
+	EventOutput = "o"
+	EventInput  = "i"
+	EventResize = "r"
+)
+
+// Header is the first line of an asciicast v2 file.
+type Header struct {
+	Version   int    `json:"version"`
+	Width     int    `json:"width"`
+	Height    int    `json:"height"`
+	Timestamp int64  `json:"timestamp,omitempty"` // unix seconds; event times are relative to it
+	Title     string `json:"title,omitempty"`
+	// IdleTimeLimit caps pauses between events during playback, in seconds.
+	IdleTimeLimit float64           `json:"idle_time_limit,omitempty"`
+	Env           map[string]string `json:"env,omitempty"`
+}
+
+// Event is a single asciicast v2 event line: [time, type, data].
+type Event struct {
+	Time float64 // seconds since Header.Timestamp
+	Type string  // EventOutput, EventInput or EventResize
+	Data string
+}
+
+// MarshalJSON encodes the event as the three-element array asciicast uses.
+func (e Event) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{e.Time, e.Type, e.Data})
+}
+
+// UnmarshalJSON decodes a [time, type, data] array.
+func (e *Event) UnmarshalJSON(b []byte) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	if len(raw) != 3 {
+		return fmt.Errorf("asciicast event has %d fields, want 3", len(raw))
+	}
+	if err := json.Unmarshal(raw[0], &e.Time); err != nil {
+		return fmt.Errorf("asciicast event time: %w", err)
+	}
+	if err := json.Unmarshal(raw[1], &e.Type); err != nil {
+		return fmt.Errorf("asciicast event type: %w", err)
+	}
+	if err := json.Unmarshal(raw[2], &e.Data); err != nil {
+		return fmt.Errorf("asciicast event data: %w", err)
+	}
+	return nil
+}
+
+// ErrClosed is returned when writing to a closed Writer.
+var ErrClosed = errors.New("recording is closed")
+
+// Writer appends events to an asciicast v2 file. Each event is written
+// straight to the file so a recording survives a crash of the backend.
+// It is safe for concurrent use.
+type Writer struct {
+	id    string
+	path  string
+	start time.Time
+
+	mu      sync.Mutex
+	file    *os.File
+	pending map[string][]byte // incomplete UTF-8 tail per event type
+	release func()            // unregisters the writer from its Store
+}
+
+// ID returns the recording's identifier within its Store.
+func (w *Writer) ID() string { return w.id }
+
+// Path returns the file the recording is written to.
+func (w *Writer) Path() string { return w.path }
+
+// Output records terminal output produced at t.
+func (w *Writer) Output(t time.Time, data []byte) error {
+	return w.write(t, EventOutput, data)
+}
+
+// Input records keyboard input sent at t.
+func (w *Writer) Input(t time.Time, data []byte) error {
+	return w.write(t, EventInput, data)
+}
+
+// Resize records a terminal resize at t.
+func (w *Writer) Resize(t time.Time, cols, rows int) error {
+	return w.write(t, EventResize, []byte(fmt.Sprintf("%dx%d", cols, rows)))
+}
+
+// write encodes one event. PTY reads can end in the middle of a UTF-8
+// sequence, which JSON would mangle, so an incomplete trailing rune is held
+// back and prepended to the next event of the same type.
+func (w *Writer) write(t time.Time, typ string, data []byte) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.file == nil {
+		return ErrClosed
+	}
+
+	data = append(w.pending[typ], data...)
+	keep := incompleteRuneLen(data)
+	w.pending[typ] = append([]byte(nil), data[len(data)-keep:]...)
+	data = data[:len(data)-keep]
+	if len(data) == 0 {
+		return nil
+	}
+
+	// Encode without HTML escaping so output stays readable ("<" rather
+	// than "\u003c"); the encoder terminates the line.
+	var line bytes.Buffer
+	enc := json.NewEncoder(&line)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode([]any{t.Sub(w.start).Seconds(), typ, string(data)}); err != nil {
+		return err
+	}
+	_, err := w.file.Write(line.Bytes())
+	return err
+}
+
+// Close finishes the recording. It is safe to call more than once.
+func (w *Writer) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.file == nil {
+		return nil
+	}
+	err := w.file.Close()
+	w.file = nil
+	if w.release != nil {
+		w.release()
+	}
+	return err
+}
+
+// incompleteRuneLen returns how many trailing bytes of b form the start of
+// a UTF-8 sequence that is not complete yet.
+func incompleteRuneLen(b []byte) int {
+	for i := 1; i < utf8.UTFMax && i <= len(b); i++ {
+		c := b[len(b)-i]
+		if c < utf8.RuneSelf {
+			return 0 // ASCII: nothing pending
+		}
+		if utf8.RuneStart(c) {
+			if utf8.FullRune(b[len(b)-i:]) {
+				return 0
+			}
+			return i
+		}
+	}
+	return 0
+}
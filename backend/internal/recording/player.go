@@ -0,0 +1,262 @@
+package recording
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// resetTerminal (RIS) clears the viewer's screen before a backwards seek
+// replays the recording from its start.
+const resetTerminal = "\x1bc"
+
+// ErrInvalidSpeed is returned for playback speeds that are not positive.
+var ErrInvalidSpeed = errors.New("playback speed must be positive")
+
+// frame is one output event placed on the playback timeline.
+type frame struct {
+	at   time.Duration // after idle-time capping
+	data []byte
+}
+
+// PlayerState is a snapshot of a Player's position and controls.
+type PlayerState struct {
+	Position time.Duration
+	Duration time.Duration
+	Speed    float64
+	Paused   bool
+}
+
+// Player replays the output events of an asciicast v2 recording with their
+// original timing. Input and resize events are not replayed. The playback
+// clock can be paused, sped up and moved while Run is streaming.
+type Player struct {
+	header   Header
+	frames   []frame
+	duration time.Duration
+
+	mu      sync.Mutex
+	next    int           // index of the next frame to send
+	base    time.Duration // playback position at anchor
+	anchor  time.Time     // wall time base was taken at
+	speed   float64
+	paused  bool
+	seek    bool          // base was moved; resend the screen up to it
+	sent    time.Duration // timeline position of the last frame sent
+	stopped bool
+	wake    chan struct{}
+}
+
+// Load reads an asciicast v2 recording for playback. Pauses between events
+// longer than idleLimit are shortened to it; if idleLimit is zero the
+// recording's own idle_time_limit, if any, applies.
+func Load(r io.Reader, idleLimit time.Duration) (*Player, error) {
+	// bufio.NewReader in ReadHeader returns br itself, so nothing past the
+	// header line is lost.
+	br := bufio.NewReader(r)
+	header, err := ReadHeader(br)
+	if err != nil {
+		return nil, err
+	}
+	if idleLimit == 0 {
+		idleLimit = time.Duration(header.IdleTimeLimit * float64(time.Second))
+	}
+
+	p := &Player{header: header, speed: 1, wake: make(chan struct{}, 1)}
+	var last time.Duration // uncapped time of the previous event
+	for lineNo := 2; ; lineNo++ {
+		line, err := br.ReadBytes('\n')
+		if len(bytes.TrimSpace(line)) > 0 {
+			var ev Event
+			if jerr := json.Unmarshal(line, &ev); jerr != nil {
+				return nil, fmt.Errorf("invalid asciicast event on line %d: %w", lineNo, jerr)
+			}
+			at := time.Duration(ev.Time * float64(time.Second))
+			gap := max(at-last, 0)
+			if idleLimit > 0 {
+				gap = min(gap, idleLimit)
+			}
+			last = max(at, last)
+			p.duration += gap
+
+			if ev.Type == EventOutput {
+				p.frames = append(p.frames, frame{at: p.duration, data: []byte(ev.Data)})
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read asciicast: %w", err)
+		}
+	}
+	return p, nil
+}
+
+// Header returns the recording's header.
+func (p *Player) Header() Header { return p.header }
+
+// State reports the current position and controls.
+func (p *Player) State() PlayerState {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return PlayerState{
+		Position: p.position(),
+		Duration: p.duration,
+		Speed:    p.speed,
+		Paused:   p.paused,
+	}
+}
+
+// Pause stops the playback clock.
+func (p *Player) Pause() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.base = p.position()
+	p.paused = true
+	p.poke()
+}
+
+// Resume restarts the playback clock.
+func (p *Player) Resume() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.paused {
+		p.anchor = time.Now()
+		p.paused = false
+	}
+	p.poke()
+}
+
+// SetSpeed changes the playback speed multiplier.
+func (p *Player) SetSpeed(speed float64) error {
+	if speed <= 0 {
+		return ErrInvalidSpeed
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.base = p.position()
+	p.anchor = time.Now()
+	p.speed = speed
+	p.poke()
+	return nil
+}
+
+// Seek moves playback to pos, clamped to the recording. Everything up to
+// pos is sent at once so the viewer shows the screen as it was then; moving
+// backwards resets the viewer's terminal first.
+func (p *Player) Seek(pos time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.base = min(max(pos, 0), p.duration)
+	p.anchor = time.Now()
+	p.seek = true
+	p.poke()
+}
+
+// Stop makes Run return without sending the rest of the recording.
+func (p *Player) Stop() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.stopped = true
+	p.poke()
+}
+
+// Run streams the recording's output to send until Stop is called or ctx
+// is cancelled. Frames that fall due together are sent as one chunk. Once
+// the last frame is sent Run keeps waiting, so a seek can still replay the
+// recording.
+func (p *Player) Run(ctx context.Context, send func(data []byte) error) error {
+	p.mu.Lock()
+	if !p.paused {
+		p.anchor = time.Now()
+	}
+	p.mu.Unlock()
+
+	for {
+		p.mu.Lock()
+		var data []byte
+		if p.stopped {
+			p.mu.Unlock()
+			return nil
+		}
+		if p.seek {
+			p.seek = false
+			if p.base < p.sent {
+				data = []byte(resetTerminal)
+				p.next, p.sent = 0, 0
+			}
+			data = append(data, p.advance(p.base)...)
+		} else if !p.paused {
+			data = p.advance(p.position())
+		}
+
+		// Nothing due yet: sleep until the next frame or a control change.
+		// At the end only a control change can bring more.
+		var timer *time.Timer
+		var wait <-chan time.Time
+		if len(data) == 0 && !p.paused && p.next < len(p.frames) {
+			timer = time.NewTimer(time.Duration(float64(p.frames[p.next].at-p.position()) / p.speed))
+			wait = timer.C
+		}
+		p.mu.Unlock()
+
+		if len(data) > 0 {
+			if err := send(data); err != nil {
+				return err
+			}
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-p.wake:
+		case <-wait:
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+	}
+}
+
+// advance collects the data of every unsent frame at or before pos. The
+// caller must hold p.mu.
+func (p *Player) advance(pos time.Duration) []byte {
+	var data []byte
+	for p.next < len(p.frames) && p.frames[p.next].at <= pos {
+		data = append(data, p.frames[p.next].data...)
+		p.sent = p.frames[p.next].at
+		p.next++
+	}
+	return data
+}
+
+// position returns the playback clock. The caller must hold p.mu.
+func (p *Player) position() time.Duration {
+	if p.paused || p.anchor.IsZero() {
+		return p.base
+	}
+	pos := p.base + time.Duration(float64(time.Since(p.anchor))*p.speed)
+	return min(pos, p.duration)
+}
+
+// poke wakes Run after a control change. The caller must hold p.mu.
+func (p *Player) poke() {
+	select {
+	case p.wake <- struct{}{}:
+	default:
+	}
+}
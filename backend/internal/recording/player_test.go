@@ -0,0 +1,273 @@
+package recording
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// load builds an asciicast v2 recording from header and events and loads
+// it for playback.
+func load(t *testing.T, header Header, idleLimit time.Duration, events ...Event) *Player {
+	t.Helper()
+	header.Version = 2
+	var b strings.Builder
+	for _, v := range append([]any{header}, toAny(events)...) {
+		line, err := json.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b.Write(line)
+		b.WriteByte('\n')
+	}
+	p, err := Load(strings.NewReader(b.String()), idleLimit)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	return p
+}
+
+func toAny(events []Event) []any {
+	out := make([]any, len(events))
+	for i, ev := range events {
+		out[i] = ev
+	}
+	return out
+}
+
+// run starts p.Run with a send function that forwards every chunk, and
+// returns the chunks and Run's result.
+func run(t *testing.T, p *Player) (<-chan string, <-chan error) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	sent := make(chan string, 100)
+	done := make(chan error, 1)
+	go func() {
+		done <- p.Run(ctx, func(data []byte) error {
+			sent <- string(data)
+			return nil
+		})
+	}()
+	return sent, done
+}
+
+// expect reads the next chunk and fails unless it is want.
+func expect(t *testing.T, sent <-chan string, want string) {
+	t.Helper()
+	select {
+	case got := <-sent:
+		if got != want {
+			t.Fatalf("sent %q, want %q", got, want)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("nothing sent, want %q", want)
+	}
+}
+
+// expectNothing fails if a chunk is sent within d.
+func expectNothing(t *testing.T, sent <-chan string, d time.Duration) {
+	t.Helper()
+	select {
+	case got := <-sent:
+		t.Fatalf("sent %q, want nothing", got)
+	case <-time.After(d):
+	}
+}
+
+func TestLoad(t *testing.T) {
+	events := []Event{
+		{Time: 0.5, Type: EventOutput, Data: "a"},
+		{Time: 1, Type: EventInput, Data: "x"},
+		{Time: 31, Type: EventResize, Data: "100x30"},
+		{Time: 32, Type: EventOutput, Data: "b"},
+	}
+
+	tests := []struct {
+		name      string
+		header    Header
+		idleLimit time.Duration
+		duration  time.Duration
+	}{
+		{"uncapped", Header{}, 0, 32 * time.Second},
+		{"file's idle limit", Header{IdleTimeLimit: 2}, 0, 4 * time.Second},
+		{"caller's idle limit wins", Header{IdleTimeLimit: 2}, time.Second, 3 * time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.header.Width, tt.header.Height = 80, 24
+			p := load(t, tt.header, tt.idleLimit, events...)
+
+			if h := p.Header(); h.Width != 80 || h.Height != 24 {
+				t.Errorf("header size = %dx%d, want 80x24", h.Width, h.Height)
+			}
+			if len(p.frames) != 2 {
+				t.Fatalf("%d frames, want the 2 output events", len(p.frames))
+			}
+			if got := p.State().Duration; got != tt.duration {
+				t.Errorf("duration = %v, want %v", got, tt.duration)
+			}
+			if got := p.frames[1].at; got != tt.duration {
+				t.Errorf("last frame at %v, want %v", got, tt.duration)
+			}
+		})
+	}
+}
+
+func TestLoadRejectsBadInput(t *testing.T) {
+	for name, input := range map[string]string{
+		"version 1":   `{"version":1,"width":80,"height":24}` + "\n",
+		"bad event":   `{"version":2,"width":80,"height":24}` + "\n" + `[1, "o"` + "\n",
+		"empty input": "",
+	} {
+		if _, err := Load(strings.NewReader(input), 0); err == nil {
+			t.Errorf("%s: Load succeeded", name)
+		}
+	}
+}
+
+// TestPlayerIdleCapping checks that a long pause in the recording is
+// shortened when played.
+func TestPlayerIdleCapping(t *testing.T) {
+	p := load(t, Header{}, 20*time.Millisecond,
+		Event{Time: 0, Type: EventOutput, Data: "a"},
+		Event{Time: 3600, Type: EventOutput, Data: "b"},
+	)
+	sent, _ := run(t, p)
+
+	start := time.Now()
+	expect(t, sent, "a")
+	expect(t, sent, "b")
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("an hour's pause took %v with a 20ms idle limit", elapsed)
+	}
+}
+
+// TestPlayerTiming checks that frames wait for their time on the timeline,
+// scaled by the speed.
+func TestPlayerTiming(t *testing.T) {
+	tests := []struct {
+		name    string
+		speed   float64
+		atLeast time.Duration
+		atMost  time.Duration
+	}{
+		{"normal speed", 1, 200 * time.Millisecond, 2 * time.Second},
+		{"ten times faster", 10, 20 * time.Millisecond, 150 * time.Millisecond},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := load(t, Header{}, 0,
+				Event{Time: 0, Type: EventOutput, Data: "a"},
+				Event{Time: 0.2, Type: EventOutput, Data: "b"},
+			)
+			if err := p.SetSpeed(tt.speed); err != nil {
+				t.Fatal(err)
+			}
+			sent, _ := run(t, p)
+
+			expect(t, sent, "a")
+			start := time.Now()
+			expect(t, sent, "b")
+			if elapsed := time.Since(start); elapsed < tt.atLeast || elapsed > tt.atMost {
+				t.Errorf("second frame after %v, want between %v and %v", elapsed, tt.atLeast, tt.atMost)
+			}
+		})
+	}
+}
+
+// TestPlayerSpeedChange checks that changing the speed mid-pause applies to
+// the time still left.
+func TestPlayerSpeedChange(t *testing.T) {
+	p := load(t, Header{}, 0,
+		Event{Time: 0, Type: EventOutput, Data: "a"},
+		Event{Time: 10, Type: EventOutput, Data: "b"},
+	)
+	sent, _ := run(t, p)
+	expect(t, sent, "a")
+
+	if err := p.SetSpeed(100); err != nil {
+		t.Fatal(err)
+	}
+	start := time.Now()
+	expect(t, sent, "b")
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("10s at 100x took %v", elapsed)
+	}
+
+	if err := p.SetSpeed(0); !errors.Is(err, ErrInvalidSpeed) {
+		t.Errorf("SetSpeed(0) = %v, want ErrInvalidSpeed", err)
+	}
+}
+
+// TestPlayerPause checks that a paused playback sends nothing until it is
+// resumed, and resumes where it stopped.
+func TestPlayerPause(t *testing.T) {
+	p := load(t, Header{}, 0,
+		Event{Time: 0, Type: EventOutput, Data: "a"},
+		Event{Time: 0.05, Type: EventOutput, Data: "b"},
+	)
+	p.Pause()
+	sent, _ := run(t, p)
+
+	expectNothing(t, sent, 150*time.Millisecond)
+	if st := p.State(); !st.Paused || st.Position != 0 {
+		t.Errorf("state = %+v, want paused at 0", st)
+	}
+
+	p.Resume()
+	expect(t, sent, "a")
+	expect(t, sent, "b")
+}
+
+// TestPlayerSeek checks that seeking sends the screen up to the new
+// position at once, resetting the viewer's terminal when moving backwards,
+// and that the stream stays open at the end so it can seek back.
+func TestPlayerSeek(t *testing.T) {
+	p := load(t, Header{}, 0,
+		Event{Time: 0, Type: EventOutput, Data: "a"},
+		Event{Time: 100, Type: EventOutput, Data: "b"},
+		Event{Time: 200, Type: EventOutput, Data: "c"},
+	)
+	p.Pause()
+	sent, done := run(t, p)
+
+	// Forward while paused: everything up to the position, no reset.
+	p.Seek(150 * time.Second)
+	expect(t, sent, "ab")
+
+	// Past the end, then back to the start.
+	p.Seek(time.Hour)
+	expect(t, sent, "c")
+	expectNothing(t, sent, 50*time.Millisecond)
+	p.Seek(0)
+	expect(t, sent, resetTerminal+"a")
+
+	select {
+	case err := <-done:
+		t.Fatalf("Run returned %v before Stop", err)
+	default:
+	}
+	p.Stop()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Run = %v, want nil after Stop", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run did not return after Stop")
+	}
+}
+
+// TestPlayerSendError checks that Run stops at the first failed send.
+func TestPlayerSendError(t *testing.T) {
+	p := load(t, Header{}, 0, Event{Time: 0, Type: EventOutput, Data: "a"})
+	errSend := errors.New("client gone")
+	if err := p.Run(context.Background(), func([]byte) error { return errSend }); err != errSend {
+		t.Errorf("Run = %v, want %v", err, errSend)
+	}
+}
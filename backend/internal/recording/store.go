@@ -0,0 +1,245 @@
+package recording
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// fileExt is the extension of asciicast files in a Store.
+const fileExt = ".cast"
+
+// tailProbe is how much of the end of a recording is read to find the time
+// of its last event when listing.
+const tailProbe = 64 << 10
+
+// Errors returned by Store.
+var (
+	ErrInvalidID = errors.New("invalid recording id")
+	ErrActive    = errors.New("recording is still active")
+)
+
+// Info describes a recording on disk.
+type Info struct {
+	ID        string
+	SessionID string
+	Path      string
+	Header    Header
+	CreatedAt time.Time     // when recording started
+	Duration  time.Duration // time of the last event, relative to the header timestamp
+	Size      int64
+	Active    bool // still being written
+}
+
+// Store manages the asciicast recordings in a directory.
+type Store struct {
+	dir string
+
+	mu     sync.Mutex
+	active map[string]*Writer
+}
+
+// NewStore creates a store for dir, creating the directory if needed.
+func NewStore(dir string) (*Store, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create recordings directory: %w", err)
+	}
+	return &Store{dir: dir, active: make(map[string]*Writer)}, nil
+}
+
+// Dir returns the directory holding the recordings.
+func (s *Store) Dir() string { return s.dir }
+
+// Create starts a new recording of a session. Event times are measured from
+// start, which is also written as the header timestamp. The recording is
+// listed as active until the writer is closed.
+func (s *Store) Create(sessionID string, start time.Time, cols, rows int, env map[string]string) (*Writer, error) {
+	id := fmt.Sprintf("%d-%s", time.Now().UnixMilli(), sessionID)
+	path := filepath.Join(s.dir, id+fileExt)
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create recording: %w", err)
+	}
+
+	header, err := json.Marshal(Header{
+		Version:   2,
+		Width:     cols,
+		Height:    rows,
+		Timestamp: start.Unix(),
+		Title:     "BlockTerm session " + sessionID,
+		Env:       env,
+	})
+	if err == nil {
+		_, err = file.Write(append(header, '\n'))
+	}
+	if err != nil {
+		file.Close()
+		os.Remove(path)
+		return nil, fmt.Errorf("failed to write recording header: %w", err)
+	}
+
+	w := &Writer{
+		id: id, path: path,
+		// Truncate to whole seconds so event times line up with the header.
+		start:   start.Truncate(time.Second),
+		file:    file,
+		pending: make(map[string][]byte),
+		release: func() {
+			s.mu.Lock()
+			delete(s.active, id)
+			s.mu.Unlock()
+		},
+	}
+
+	s.mu.Lock()
+	s.active[id] = w
+	s.mu.Unlock()
+	return w, nil
+}
+
+// List returns every recording in the store, newest first. Files that are
+// not valid asciicast v2 are skipped.
+func (s *Store) List() ([]Info, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read recordings directory: %w", err)
+	}
+
+	var infos []Info
+	for _, entry := range entries {
+		id, ok := strings.CutSuffix(entry.Name(), fileExt)
+		if !ok || entry.IsDir() {
+			continue
+		}
+		info, err := s.Stat(id)
+		if err != nil {
+			continue
+		}
+		infos = append(infos, info)
+	}
+
+	sort.Slice(infos, func(i, j int) bool { return infos[i].ID > infos[j].ID })
+	return infos, nil
+}
+
+// Stat describes a single recording.
+func (s *Store) Stat(id string) (Info, error) {
+	path, err := s.Path(id)
+	if err != nil {
+		return Info{}, err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return Info{}, err
+	}
+	defer file.Close()
+
+	fi, err := file.Stat()
+	if err != nil {
+		return Info{}, err
+	}
+	header, err := ReadHeader(file)
+	if err != nil {
+		return Info{}, err
+	}
+
+	info := Info{
+		ID:     id,
+		Path:   path,
+		Header: header,
+		Size:   fi.Size(),
+	}
+	if created, sessionID, ok := parseID(id); ok {
+		info.CreatedAt, info.SessionID = created, sessionID
+	}
+	info.Duration = lastEventTime(file, fi.Size())
+
+	s.mu.Lock()
+	_, info.Active = s.active[id]
+	s.mu.Unlock()
+	return info, nil
+}
+
+// Delete removes a recording. Recordings still being written cannot be
+// deleted.
+func (s *Store) Delete(id string) error {
+	path, err := s.Path(id)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	_, active := s.active[id]
+	s.mu.Unlock()
+	if active {
+		return fmt.Errorf("%w: %s", ErrActive, id)
+	}
+	return os.Remove(path)
+}
+
+// Path returns the file of recording id. IDs are plain file names; anything
+// that could escape the store directory is rejected.
+func (s *Store) Path(id string) (string, error) {
+	if id == "" || id != filepath.Base(id) || strings.HasPrefix(id, ".") {
+		return "", fmt.Errorf("%w: %q", ErrInvalidID, id)
+	}
+	return filepath.Join(s.dir, id+fileExt), nil
+}
+
+// ReadHeader reads and validates the header line of an asciicast v2 stream.
+func ReadHeader(r io.Reader) (Header, error) {
+	line, err := bufio.NewReader(r).ReadBytes('\n')
+	if err != nil && len(line) == 0 {
+		return Header{}, fmt.Errorf("failed to read asciicast header: %w", err)
+	}
+	var header Header
+	if err := json.Unmarshal(line, &header); err != nil {
+		return Header{}, fmt.Errorf("invalid asciicast header: %w", err)
+	}
+	if header.Version != 2 {
+		return Header{}, fmt.Errorf("unsupported asciicast version %d", header.Version)
+	}
+	return header, nil
+}
+
+// lastEventTime returns the time of the last complete event in a recording
+// of the given size, reading only its tail.
+func lastEventTime(r io.ReaderAt, size int64) time.Duration {
+	off := max(size-tailProbe, 0)
+	buf := make([]byte, size-off)
+	n, _ := r.ReadAt(buf, off)
+	lines := bytes.Split(bytes.TrimRight(buf[:n], "\n"), []byte("\n"))
+
+	for i := len(lines) - 1; i >= 0; i-- {
+		var ev Event
+		if json.Unmarshal(lines[i], &ev) == nil {
+			return time.Duration(ev.Time * float64(time.Second))
+		}
+	}
+	return 0
+}
+
+// parseID splits a recording ID into its creation time and session ID.
+func parseID(id string) (time.Time, string, bool) {
+	ms, sessionID, ok := strings.Cut(id, "-")
+	if !ok {
+		return time.Time{}, "", false
+	}
+	n, err := strconv.ParseInt(ms, 10, 64)
+	if err != nil {
+		return time.Time{}, "", false
+	}
+	return time.UnixMilli(n), sessionID, true
+}
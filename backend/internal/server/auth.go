@@ -0,0 +1,106 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"os"
+
+	pb "github.com/entl/blockterm/gen/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// ServerTokenHeader is the metadata key clients send the server token in.
+const ServerTokenHeader = "blockterm-server-token"
+
+// sessionScopedMethods are the RPCs about particular sessions or broadcast
+// groups. They authorize each request with the owner, share or group token
+// it carries, so a client given only a share token can use them; every
+// other RPC takes the server token.
+var sessionScopedMethods = map[string]bool{
+	pb.TerminalService_CloseSession_FullMethodName:         true,
+	pb.TerminalService_SendInput_FullMethodName:            true,
+	pb.TerminalService_ReceiveOutput_FullMethodName:        true,
+	pb.TerminalService_Attach_FullMethodName:               true,
+	pb.TerminalService_SubscribeAll_FullMethodName:         true,
+	pb.TerminalService_ResizeSession_FullMethodName:        true,
+	pb.TerminalService_GetSession_FullMethodName:           true,
+	pb.TerminalService_DetachSession_FullMethodName:        true,
+	pb.TerminalService_GetCwdHistory_FullMethodName:        true,
+	pb.TerminalService_SignalSession_FullMethodName:        true,
+	pb.TerminalService_SetRecording_FullMethodName:         true,
+	pb.TerminalService_CreateBroadcastGroup_FullMethodName: true,
+	pb.TerminalService_UpdateBroadcastGroup_FullMethodName: true,
+	pb.TerminalService_DeleteBroadcastGroup_FullMethodName: true,
+	pb.TerminalService_SendGroupInput_FullMethodName:       true,
+	pb.TerminalService_ShareSession_FullMethodName:         true,
+	pb.TerminalService_RevokeShare_FullMethodName:          true,
+	pb.TerminalService_WaitForOutput_FullMethodName:        true,
+}
+
+// Auth requires the server token on every RPC that is not scoped to a
+// session. The token is written to a file only the user the server runs as
+// can read, so only that user's clients can start shells, run commands,
+// list sessions or read history and recordings.
+type Auth struct {
+	token string
+}
+
+// NewAuth creates an Auth accepting token.
+func NewAuth(token string) *Auth {
+	return &Auth{token: token}
+}
+
+// WriteServerToken generates a server token and writes it to path,
+// readable only by the current user, replacing any previous one.
+func WriteServerToken(path string) (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", fmt.Errorf("failed to generate server token: %w", err)
+	}
+	token := hex.EncodeToString(secret)
+
+	// WriteFile keeps the mode of an existing file.
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return "", fmt.Errorf("failed to replace server token: %w", err)
+	}
+	if err := os.WriteFile(path, []byte(token+"\n"), 0o600); err != nil {
+		return "", fmt.Errorf("failed to write server token: %w", err)
+	}
+	return token, nil
+}
+
+// UnaryInterceptor checks the server token of unary RPCs.
+func (a *Auth) UnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := a.check(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamInterceptor checks the server token of streaming RPCs.
+func (a *Auth) StreamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := a.check(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// check reports whether a call to method may go ahead.
+func (a *Auth) check(ctx context.Context, method string) error {
+	if sessionScopedMethods[method] {
+		return nil
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, token := range md.Get(ServerTokenHeader) {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(a.token)) == 1 {
+			return nil
+		}
+	}
+	return status.Errorf(codes.Unauthenticated, "%s requires the server token", method)
+}
@@ -0,0 +1,79 @@
+package server
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/entl/blockterm/gen/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// TestAuth checks that only the RPCs scoped to a session go ahead without
+// the server token.
+func TestAuth(t *testing.T) {
+	auth := NewAuth("secret")
+
+	tests := []struct {
+		name   string
+		method string
+		token  string // "" sends none
+		ok     bool
+	}{
+		{"start session with token", pb.TerminalService_StartSession_FullMethodName, "secret", true},
+		{"start session without token", pb.TerminalService_StartSession_FullMethodName, "", false},
+		{"start session with wrong token", pb.TerminalService_StartSession_FullMethodName, "guess", false},
+		{"list sessions without token", pb.TerminalService_ListSessions_FullMethodName, "", false},
+		{"run command without token", pb.TerminalService_RunCommand_FullMethodName, "", false},
+		{"open playback without token", pb.PlaybackService_OpenPlayback_FullMethodName, "", false},
+		{"query history without token", pb.HistoryService_QueryHistory_FullMethodName, "", false},
+		{"session output without token", pb.TerminalService_ReceiveOutput_FullMethodName, "", true},
+		{"group input without token", pb.TerminalService_SendGroupInput_FullMethodName, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.token != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(ServerTokenHeader, tt.token))
+			}
+			err := auth.check(ctx, tt.method)
+			if tt.ok && err != nil {
+				t.Fatalf("check = %v, want allowed", err)
+			}
+			if !tt.ok && status.Code(err) != codes.Unauthenticated {
+				t.Fatalf("check = %v, want Unauthenticated", err)
+			}
+		})
+	}
+}
+
+// TestWriteServerToken checks that each token replaces the last and that
+// only the owner can read it.
+func TestWriteServerToken(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "server-token")
+	if err := os.WriteFile(path, []byte("old\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	token, err := WriteServerToken(path)
+	if err != nil {
+		t.Fatalf("WriteServerToken: %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != token+"\n" || len(token) != 64 {
+		t.Errorf("file = %q, want the 64-digit token %q", data, token)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if perm := info.Mode().Perm(); perm != 0o600 {
+		t.Errorf("mode = %o, want 600", perm)
+	}
+}
@@ -2,9 +2,12 @@ package server
 
 import (
 	"context"
+	"path/filepath"
+	"time"
 
 	pb "github.com/entl/blockterm/gen/proto"
 	"github.com/entl/blockterm/internal/history"
+	"github.com/entl/blockterm/internal/session"
 	"github.com/entl/blockterm/internal/storage"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -13,22 +16,43 @@ import (
 // HistoryServer implements pb.HistoryServiceServer.
 type HistoryServer struct {
 	pb.UnimplementedHistoryServiceServer
-	svc *history.Service
+	svc        *history.Service
+	sessionMgr *session.Manager
 }
 
 // NewHistoryServer creates a HistoryServer backed by the given service.
-func NewHistoryServer(svc *history.Service) *HistoryServer {
-	return &HistoryServer{svc: svc}
+// The session manager is used to resolve the shell of recorded commands.
+func NewHistoryServer(svc *history.Service, sessionMgr *session.Manager) *HistoryServer {
+	return &HistoryServer{svc: svc, sessionMgr: sessionMgr}
 }
 
 // RecordCommand persists a command to history.
 // The write is enqueued asynchronously; the Ack is returned immediately.
+//
+// Commands run in BlockTerm sessions with shell integration are recorded
+// by the backend automatically; this RPC is for clients that execute
+// commands some other way.
 func (h *HistoryServer) RecordCommand(_ context.Context, req *pb.RecordCommandRequest) (*pb.Ack, error) {
 	if req.SessionId == "" {
 		return nil, status.Error(codes.InvalidArgument, "session_id is required")
 	}
-	// shell is unknown to the client; session_id is the primary identity.
-	h.svc.RecordCommand(req.SessionId, "", req.Cwd, req.Command)
+
+	// The client does not know the shell; resolve it from the live session.
+	shell := ""
+	if sess, err := h.sessionMgr.GetSession(req.SessionId); err == nil {
+		shell = filepath.Base(sess.Shell)
+	}
+
+	var ts time.Time
+	if req.Timestamp > 0 {
+		ts = time.Unix(req.Timestamp, 0)
+	}
+	var exitCode *int
+	if req.ExitCode != nil {
+		code := int(*req.ExitCode)
+		exitCode = &code
+	}
+	h.svc.RecordEntry(req.SessionId, shell, req.Cwd, req.Command, exitCode, ts)
 	return &pb.Ack{Ok: true}, nil
 }
 
@@ -53,9 +77,10 @@ func (h *HistoryServer) QueryHistory(ctx context.Context, req *pb.QueryHistoryRe
 
 	entries := make([]*pb.RecordCommandRequest, 0, len(results))
 	for _, c := range results {
-		exitCode := int32(0)
+		var exitCode *int32
 		if c.ExitCode != nil {
-			exitCode = int32(*c.ExitCode)
+			code := int32(*c.ExitCode)
+			exitCode = &code
 		}
 		entries = append(entries, &pb.RecordCommandRequest{
 			SessionId: c.SessionID,
@@ -0,0 +1,219 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	pb "github.com/entl/blockterm/gen/proto"
+	"github.com/entl/blockterm/internal/recording"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// playbackOpenTimeout is how long an opened playback waits to be streamed
+// before it is discarded.
+const playbackOpenTimeout = time.Minute
+
+// playback is an opened recording waiting to be, or being, streamed.
+type playback struct {
+	player    *recording.Player
+	streaming bool
+	expiry    *time.Timer // discards the playback if it is never streamed
+}
+
+// PlaybackServer implements pb.PlaybackServiceServer.
+type PlaybackServer struct {
+	pb.UnimplementedPlaybackServiceServer
+	store *recording.Store
+
+	mu        sync.Mutex
+	playbacks map[string]*playback
+}
+
+// NewPlaybackServer creates a PlaybackServer. Recordings are looked up by ID
+// in store; any other asciicast file can be played by path.
+func NewPlaybackServer(store *recording.Store) *PlaybackServer {
+	return &PlaybackServer{store: store, playbacks: make(map[string]*playback)}
+}
+
+// OpenPlayback loads a recording and returns the ID to stream and control it
+// with.
+func (p *PlaybackServer) OpenPlayback(_ context.Context, req *pb.OpenPlaybackRequest) (*pb.PlaybackInfo, error) {
+	path := req.Path
+	switch {
+	case req.RecordingId != "" && req.Path != "":
+		return nil, status.Error(codes.InvalidArgument, "recording_id and path are mutually exclusive")
+	case req.RecordingId != "":
+		var err error
+		if path, err = p.store.Path(req.RecordingId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	case req.Path == "":
+		return nil, status.Error(codes.InvalidArgument, "recording_id or path is required")
+	}
+	if req.Speed < 0 || req.IdleTimeLimit < 0 {
+		return nil, status.Error(codes.InvalidArgument, "speed and idle_time_limit must not be negative")
+	}
+
+	file, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, status.Errorf(codes.NotFound, "recording not found: %s", path)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to open recording: %v", err)
+	}
+	defer file.Close()
+
+	player, err := recording.Load(file, time.Duration(req.IdleTimeLimit*float64(time.Second)))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to load recording: %v", err)
+	}
+	if req.Speed > 0 {
+		player.SetSpeed(req.Speed)
+	}
+	if req.StartPaused {
+		player.Pause()
+	}
+
+	id := uuid.New().String()
+	p.mu.Lock()
+	p.playbacks[id] = &playback{
+		player: player,
+		expiry: time.AfterFunc(playbackOpenTimeout, func() { p.expire(id) }),
+	}
+	p.mu.Unlock()
+
+	log.Printf("opened playback %s of %s", id, path)
+	return toProtoPlaybackInfo(id, player), nil
+}
+
+// StreamPlayback sends the recording's output as OutputChunks tagged with
+// the playback ID, until the client goes away or closes the playback. The
+// stream stays open after the last frame so the client can seek back. A
+// playback can be streamed once; it is closed when the stream ends.
+func (p *PlaybackServer) StreamPlayback(req *pb.StreamPlaybackRequest, stream pb.PlaybackService_StreamPlaybackServer) error {
+	if req.PlaybackId == "" {
+		return status.Error(codes.InvalidArgument, "playback_id is required")
+	}
+
+	p.mu.Lock()
+	pbk, ok := p.playbacks[req.PlaybackId]
+	if ok && pbk.streaming {
+		p.mu.Unlock()
+		return status.Errorf(codes.FailedPrecondition, "playback is already streaming: %s", req.PlaybackId)
+	}
+	if ok {
+		pbk.streaming = true
+		pbk.expiry.Stop()
+	}
+	p.mu.Unlock()
+	if !ok {
+		return status.Errorf(codes.NotFound, "playback not found: %s", req.PlaybackId)
+	}
+	defer p.remove(req.PlaybackId)
+
+	var offset uint64
+	err := pbk.player.Run(stream.Context(), func(data []byte) error {
+		chunk := &pb.OutputChunk{SessionId: req.PlaybackId, Data: data, Offset: offset}
+		offset += uint64(len(data))
+		return stream.Send(chunk)
+	})
+	if err != nil && stream.Context().Err() == nil {
+		log.Printf("error streaming playback %s: %v", req.PlaybackId, err)
+		return status.Errorf(codes.Internal, "error streaming playback: %v", err)
+	}
+	return stream.Context().Err()
+}
+
+// ControlPlayback pauses, resumes, seeks or changes the speed of a playback.
+func (p *PlaybackServer) ControlPlayback(_ context.Context, req *pb.ControlPlaybackRequest) (*pb.PlaybackInfo, error) {
+	if req.PlaybackId == "" {
+		return nil, status.Error(codes.InvalidArgument, "playback_id is required")
+	}
+	player, ok := p.get(req.PlaybackId)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "playback not found: %s", req.PlaybackId)
+	}
+
+	switch req.Action {
+	case "pause":
+		player.Pause()
+	case "resume":
+		player.Resume()
+	case "seek":
+		player.Seek(time.Duration(req.PositionMs) * time.Millisecond)
+	case "speed":
+		if err := player.SetSpeed(req.Speed); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown playback action: %q", req.Action)
+	}
+	return toProtoPlaybackInfo(req.PlaybackId, player), nil
+}
+
+// ClosePlayback discards a playback, ending its stream if one is running.
+func (p *PlaybackServer) ClosePlayback(_ context.Context, req *pb.ClosePlaybackRequest) (*pb.Ack, error) {
+	if req.PlaybackId == "" {
+		return nil, status.Error(codes.InvalidArgument, "playback_id is required")
+	}
+	player, ok := p.get(req.PlaybackId)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "playback not found: %s", req.PlaybackId)
+	}
+	p.remove(req.PlaybackId)
+	player.Stop()
+	return &pb.Ack{Ok: true}, nil
+}
+
+func (p *PlaybackServer) get(id string) (*recording.Player, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	pbk, ok := p.playbacks[id]
+	if !ok {
+		return nil, false
+	}
+	return pbk.player, true
+}
+
+func (p *PlaybackServer) remove(id string) {
+	p.mu.Lock()
+	if pbk, ok := p.playbacks[id]; ok {
+		pbk.expiry.Stop()
+		delete(p.playbacks, id)
+	}
+	p.mu.Unlock()
+}
+
+// expire discards a playback that was opened but never streamed.
+func (p *PlaybackServer) expire(id string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if pbk, ok := p.playbacks[id]; ok && !pbk.streaming {
+		delete(p.playbacks, id)
+		log.Printf("discarded playback %s: not streamed within %v", id, playbackOpenTimeout)
+	}
+}
+
+// toProtoPlaybackInfo describes a playback in its wire form.
+func toProtoPlaybackInfo(id string, player *recording.Player) *pb.PlaybackInfo {
+	header, state := player.Header(), player.State()
+	return &pb.PlaybackInfo{
+		PlaybackId: id,
+		Title:      header.Title,
+		Cols:       uint32(header.Width),
+		Rows:       uint32(header.Height),
+		DurationMs: state.Duration.Milliseconds(),
+		PositionMs: state.Position.Milliseconds(),
+		Speed:      state.Speed,
+		Paused:     state.Paused,
+	}
+}
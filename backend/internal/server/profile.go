@@ -0,0 +1,134 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/entl/blockterm/gen/proto"
+	"github.com/entl/blockterm/internal/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// ProfileServer implements pb.ProfileServiceServer.
+type ProfileServer struct {
+	pb.UnimplementedProfileServiceServer
+	db *storage.DB
+}
+
+// NewProfileServer creates a ProfileServer for the profiles stored in db.
+func NewProfileServer(db *storage.DB) *ProfileServer {
+	return &ProfileServer{db: db}
+}
+
+// CreateProfile stores a new profile and returns it with its ID.
+func (p *ProfileServer) CreateProfile(ctx context.Context, req *pb.Profile) (*pb.Profile, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	profile := fromProtoProfile(req)
+	if err := p.db.CreateProfile(ctx, profile); err != nil {
+		return nil, profileError(err)
+	}
+	return toProtoProfile(profile), nil
+}
+
+// ListProfiles returns every profile, ordered by name.
+func (p *ProfileServer) ListProfiles(ctx context.Context, _ *emptypb.Empty) (*pb.ListProfilesResponse, error) {
+	profiles, err := p.db.ListProfiles(ctx)
+	if err != nil {
+		return nil, profileError(err)
+	}
+
+	resp := &pb.ListProfilesResponse{
+		Profiles: make([]*pb.Profile, 0, len(profiles)),
+	}
+	for _, profile := range profiles {
+		resp.Profiles = append(resp.Profiles, toProtoProfile(profile))
+	}
+	return resp, nil
+}
+
+// GetProfile returns one profile.
+func (p *ProfileServer) GetProfile(ctx context.Context, req *pb.GetProfileRequest) (*pb.Profile, error) {
+	if req.ProfileId == "" {
+		return nil, status.Error(codes.InvalidArgument, "profile_id is required")
+	}
+
+	profile, err := p.db.GetProfile(ctx, req.ProfileId)
+	if err != nil {
+		return nil, profileError(err)
+	}
+	return toProtoProfile(profile), nil
+}
+
+// UpdateProfile replaces a profile's fields and returns the stored result.
+func (p *ProfileServer) UpdateProfile(ctx context.Context, req *pb.Profile) (*pb.Profile, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	profile := fromProtoProfile(req)
+	if err := p.db.UpdateProfile(ctx, profile); err != nil {
+		return nil, profileError(err)
+	}
+	return toProtoProfile(profile), nil
+}
+
+// DeleteProfile removes a profile. Sessions started from it are unaffected.
+func (p *ProfileServer) DeleteProfile(ctx context.Context, req *pb.DeleteProfileRequest) (*pb.Ack, error) {
+	if req.ProfileId == "" {
+		return nil, status.Error(codes.InvalidArgument, "profile_id is required")
+	}
+
+	if err := p.db.DeleteProfile(ctx, req.ProfileId); err != nil {
+		return nil, profileError(err)
+	}
+	return &pb.Ack{Ok: true}, nil
+}
+
+// profileError maps a storage error to a gRPC status.
+func profileError(err error) error {
+	switch {
+	case errors.Is(err, storage.ErrProfileNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, storage.ErrProfileExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "profile storage failed: %v", err)
+	}
+}
+
+// fromProtoProfile converts a profile from its wire form. The timestamps are
+// managed by storage and ignored.
+func fromProtoProfile(p *pb.Profile) *storage.Profile {
+	return &storage.Profile{
+		ID:              p.Id,
+		Name:            p.Name,
+		Shell:           p.Shell,
+		Args:            p.Args,
+		Env:             p.Env,
+		Cwd:             p.Cwd,
+		StartupCommands: p.StartupCommands,
+	}
+}
+
+// toProtoProfile converts a stored profile into its wire form.
+func toProtoProfile(p *storage.Profile) *pb.Profile {
+	return &pb.Profile{
+		Id:              p.ID,
+		Name:            p.Name,
+		Shell:           p.Shell,
+		Args:            p.Args,
+		Env:             p.Env,
+		Cwd:             p.Cwd,
+		StartupCommands: p.StartupCommands,
+		CreatedAt:       p.CreatedAt.Unix(),
+		UpdatedAt:       p.UpdatedAt.Unix(),
+	}
+}
@@ -0,0 +1,72 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+
+	pb "github.com/entl/blockterm/gen/proto"
+	"github.com/entl/blockterm/internal/recording"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// RecordingServer implements pb.RecordingServiceServer.
+type RecordingServer struct {
+	pb.UnimplementedRecordingServiceServer
+	store *recording.Store
+}
+
+// NewRecordingServer creates a RecordingServer for the recordings in store.
+func NewRecordingServer(store *recording.Store) *RecordingServer {
+	return &RecordingServer{store: store}
+}
+
+// ListRecordings returns every recording on disk, newest first.
+func (r *RecordingServer) ListRecordings(_ context.Context, _ *emptypb.Empty) (*pb.ListRecordingsResponse, error) {
+	infos, err := r.store.List()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list recordings: %v", err)
+	}
+
+	resp := &pb.ListRecordingsResponse{
+		Recordings: make([]*pb.RecordingInfo, 0, len(infos)),
+	}
+	for _, info := range infos {
+		resp.Recordings = append(resp.Recordings, &pb.RecordingInfo{
+			RecordingId: info.ID,
+			SessionId:   info.SessionID,
+			Path:        info.Path,
+			Title:       info.Header.Title,
+			CreatedAt:   info.CreatedAt.Unix(),
+			DurationMs:  info.Duration.Milliseconds(),
+			SizeBytes:   info.Size,
+			Cols:        uint32(info.Header.Width),
+			Rows:        uint32(info.Header.Height),
+			Active:      info.Active,
+		})
+	}
+	return resp, nil
+}
+
+// DeleteRecording removes a finished recording.
+func (r *RecordingServer) DeleteRecording(_ context.Context, req *pb.DeleteRecordingRequest) (*pb.Ack, error) {
+	if req.RecordingId == "" {
+		return nil, status.Error(codes.InvalidArgument, "recording_id is required")
+	}
+
+	err := r.store.Delete(req.RecordingId)
+	switch {
+	case err == nil:
+		return &pb.Ack{Ok: true}, nil
+	case errors.Is(err, recording.ErrInvalidID):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, fs.ErrNotExist):
+		return nil, status.Errorf(codes.NotFound, "recording not found: %s", req.RecordingId)
+	case errors.Is(err, recording.ErrActive):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return nil, status.Errorf(codes.Internal, "failed to delete recording: %v", err)
+	}
+}
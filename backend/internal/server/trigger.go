@@ -0,0 +1,238 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+
+	pb "github.com/entl/blockterm/gen/proto"
+	"github.com/entl/blockterm/internal/session"
+	"github.com/entl/blockterm/internal/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// TriggerServer implements pb.TriggerServiceServer. Every change is applied
+// to the session manager straight away.
+type TriggerServer struct {
+	pb.UnimplementedTriggerServiceServer
+	db         *storage.DB
+	sessionMgr *session.Manager
+
+	// Access of the triggers that need it, by trigger ID. Tokens are not
+	// stored, so this only lasts while the server runs.
+	mu     sync.Mutex
+	access map[string]session.Access
+}
+
+// NewTriggerServer creates a TriggerServer for the triggers stored in db,
+// evaluated by sessionMgr. Call LoadTriggers to apply the stored ones.
+func NewTriggerServer(db *storage.DB, sessionMgr *session.Manager) *TriggerServer {
+	return &TriggerServer{db: db, sessionMgr: sessionMgr, access: make(map[string]session.Access)}
+}
+
+// LoadTriggers hands every enabled stored trigger to the session manager.
+// Triggers that no longer compile, or that need access to their session
+// and were not given it since the server started, are logged and skipped.
+func (t *TriggerServer) LoadTriggers(ctx context.Context) error {
+	stored, err := t.db.ListTriggers(ctx)
+	if err != nil {
+		return err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var triggers []session.Trigger
+	for _, st := range stored {
+		if !st.Enabled {
+			continue
+		}
+		trigger, err := session.CompileTrigger(st)
+		if err != nil {
+			log.Printf("skipping trigger %s: %v", st.ID, err)
+			continue
+		}
+		if trigger.NeedsAccess() {
+			access, ok := t.access[st.ID]
+			if !ok {
+				log.Printf("skipping trigger %s: no access to session %s; update it with the session's owner token", st.ID, st.ScopeID)
+				continue
+			}
+			trigger.Access = &access
+		}
+		triggers = append(triggers, trigger)
+	}
+	t.sessionMgr.SetTriggers(triggers)
+	return nil
+}
+
+// CreateTrigger stores a new trigger and returns it with its ID.
+func (t *TriggerServer) CreateTrigger(ctx context.Context, req *pb.Trigger) (*pb.Trigger, error) {
+	trigger := fromProtoTrigger(req)
+	compiled, err := session.CompileTrigger(trigger)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	access, err := t.triggerAccess(compiled, req.ShareToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := t.db.CreateTrigger(ctx, trigger); err != nil {
+		return nil, triggerError(err)
+	}
+	t.setAccess(trigger.ID, access)
+	if err := t.LoadTriggers(ctx); err != nil {
+		return nil, triggerError(err)
+	}
+	return toProtoTrigger(trigger), nil
+}
+
+// ListTriggers returns every trigger, oldest first.
+func (t *TriggerServer) ListTriggers(ctx context.Context, _ *emptypb.Empty) (*pb.ListTriggersResponse, error) {
+	triggers, err := t.db.ListTriggers(ctx)
+	if err != nil {
+		return nil, triggerError(err)
+	}
+
+	resp := &pb.ListTriggersResponse{
+		Triggers: make([]*pb.Trigger, 0, len(triggers)),
+	}
+	for _, trigger := range triggers {
+		resp.Triggers = append(resp.Triggers, toProtoTrigger(trigger))
+	}
+	return resp, nil
+}
+
+// GetTrigger returns one trigger.
+func (t *TriggerServer) GetTrigger(ctx context.Context, req *pb.GetTriggerRequest) (*pb.Trigger, error) {
+	if req.TriggerId == "" {
+		return nil, status.Error(codes.InvalidArgument, "trigger_id is required")
+	}
+
+	trigger, err := t.db.GetTrigger(ctx, req.TriggerId)
+	if err != nil {
+		return nil, triggerError(err)
+	}
+	return toProtoTrigger(trigger), nil
+}
+
+// UpdateTrigger replaces a trigger's fields and returns the stored result.
+func (t *TriggerServer) UpdateTrigger(ctx context.Context, req *pb.Trigger) (*pb.Trigger, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	trigger := fromProtoTrigger(req)
+	compiled, err := session.CompileTrigger(trigger)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	access, err := t.triggerAccess(compiled, req.ShareToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := t.db.UpdateTrigger(ctx, trigger); err != nil {
+		return nil, triggerError(err)
+	}
+	t.setAccess(trigger.ID, access)
+	if err := t.LoadTriggers(ctx); err != nil {
+		return nil, triggerError(err)
+	}
+	return toProtoTrigger(trigger), nil
+}
+
+// DeleteTrigger removes a trigger.
+func (t *TriggerServer) DeleteTrigger(ctx context.Context, req *pb.DeleteTriggerRequest) (*pb.Ack, error) {
+	if req.TriggerId == "" {
+		return nil, status.Error(codes.InvalidArgument, "trigger_id is required")
+	}
+
+	if err := t.db.DeleteTrigger(ctx, req.TriggerId); err != nil {
+		return nil, triggerError(err)
+	}
+	t.setAccess(req.TriggerId, nil)
+	if err := t.LoadTriggers(ctx); err != nil {
+		return nil, triggerError(err)
+	}
+	return &pb.Ack{Ok: true}, nil
+}
+
+// triggerAccess authorizes a trigger that needs access to its session with
+// the token the client presented, which must be the session's owner token.
+// It returns nil for triggers that need no access.
+func (t *TriggerServer) triggerAccess(trigger session.Trigger, token string) (*session.Access, error) {
+	if !trigger.NeedsAccess() {
+		return nil, nil
+	}
+	access, err := t.sessionMgr.Authorize(trigger.ScopeID, token)
+	if errors.Is(err, session.ErrSessionNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	if err := access.CheckOwner(); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "action %q: %v", trigger.Action, err)
+	}
+	return &access, nil
+}
+
+// setAccess records the access a trigger acts with, or forgets it if access
+// is nil.
+func (t *TriggerServer) setAccess(triggerID string, access *session.Access) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if access == nil {
+		delete(t.access, triggerID)
+		return
+	}
+	t.access[triggerID] = *access
+}
+
+// triggerError maps a storage error to a gRPC status.
+func triggerError(err error) error {
+	if errors.Is(err, storage.ErrTriggerNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return status.Errorf(codes.Internal, "trigger storage failed: %v", err)
+}
+
+// fromProtoTrigger converts a trigger from its wire form, defaulting the
+// scope to every session. The timestamps are managed by storage and ignored.
+func fromProtoTrigger(t *pb.Trigger) *storage.Trigger {
+	scope := t.Scope
+	if scope == "" {
+		scope = session.TriggerScopeAll
+	}
+	return &storage.Trigger{
+		ID:       t.Id,
+		Name:     t.Name,
+		Pattern:  t.Pattern,
+		Scope:    scope,
+		ScopeID:  t.ScopeId,
+		Action:   t.Action,
+		Argument: t.Argument,
+		Enabled:  t.Enabled,
+	}
+}
+
+// toProtoTrigger converts a stored trigger into its wire form.
+func toProtoTrigger(t *storage.Trigger) *pb.Trigger {
+	return &pb.Trigger{
+		Id:        t.ID,
+		Name:      t.Name,
+		Pattern:   t.Pattern,
+		Scope:     t.Scope,
+		ScopeId:   t.ScopeID,
+		Action:    t.Action,
+		Argument:  t.Argument,
+		Enabled:   t.Enabled,
+		CreatedAt: t.CreatedAt.Unix(),
+		UpdatedAt: t.UpdatedAt.Unix(),
+	}
+}
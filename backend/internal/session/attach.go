@@ -0,0 +1,213 @@
+package session
+
+import (
+	"errors"
+	"io"
+	"log"
+
+	pb "github.com/entl/blockterm/gen/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// attachReplyBuffer bounds the replies to client frames (errors, signal
+// results) waiting to be sent; the client's frames are not read while full.
+const attachReplyBuffer = 64
+
+// Attach serves a session over one bidirectional stream. Client frames are
+// applied in the order they arrive and a failing frame is reported with an
+// AttachError instead of ending the stream. Output is sent while the client
+// has credit; once it runs out, output waits in the subscription's queue,
+// where the overflow policy applies, until the client acks more.
+func (s *Service) Attach(stream pb.TerminalService_AttachServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	open := first.GetOpen()
+	if open == nil {
+		return status.Error(codes.InvalidArgument, "first attach frame must be open")
+	}
+	if open.SessionId == "" {
+		return status.Error(codes.InvalidArgument, "session_id is required")
+	}
+	sessionID := open.SessionId
+
+	access, err := s.authorize(sessionID, open.ShareToken)
+	if err != nil {
+		return err
+	}
+	policy, err := ParseOverflowPolicy(open.OverflowPolicy)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sub, replay, err := s.manager.Subscribe(sessionID, fromPosition(open.FromOffset, open.AfterSeq), policy)
+	if errors.Is(err, ErrSessionNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to subscribe to output: %v", err)
+	}
+	defer s.manager.Unsubscribe(sessionID, sub)
+	defer s.manager.OpenStream(sessionID, access)()
+
+	credits := make(chan uint64, attachReplyBuffer)
+	replies := make(chan *pb.AttachResponse, attachReplyBuffer)
+	recvDone := make(chan error, 1)
+	go func() {
+		recvDone <- s.attachRecv(stream, sessionID, open.ShareToken, credits, replies)
+	}()
+
+	// window is the output credit left; it may dip below zero since whole
+	// chunks are sent.
+	flowControl := open.Credits > 0
+	window := int64(open.Credits)
+	pending := replay
+
+	send := func(chunk OutputChunk) error {
+		if err := stream.Send(&pb.AttachResponse{Frame: &pb.AttachResponse_Output{Output: toProtoChunk(chunk)}}); err != nil {
+			log.Printf("error streaming output for session %s: %v", sessionID, err)
+			return status.Errorf(codes.Internal, "error streaming output: %v", err)
+		}
+		window -= int64(len(chunk.Data))
+		return nil
+	}
+
+	for {
+		for len(pending) > 0 && (!flowControl || window > 0) {
+			chunk := pending[0]
+			pending = pending[1:]
+			if err := send(chunk); err != nil {
+				return err
+			}
+		}
+
+		// Only take more output once everything pending is sent and the
+		// client has credit for it.
+		var output <-chan OutputChunk
+		if len(pending) == 0 && (!flowControl || window > 0) {
+			output = sub.C
+		}
+
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+
+		case err := <-recvDone:
+			if err != io.EOF {
+				return err
+			}
+			// The client is done sending but still reading output.
+			recvDone = nil
+
+		case <-access.Revoked():
+			log.Printf("session %s: share revoked, closing attach stream", sessionID)
+			return status.Error(codes.PermissionDenied, "share revoked")
+
+		case <-sub.Done():
+			var exit *ExitError
+			switch err := sub.Err(); {
+			case errors.As(err, &exit):
+				// The stream is ending: flush the shell's last output
+				// regardless of credit, then say how it ended.
+				for len(sub.C) > 0 {
+					pending = append(pending, <-sub.C)
+				}
+				for _, chunk := range append(pending, exitChunk(sessionID, exit)) {
+					if err := send(chunk); err != nil {
+						return err
+					}
+				}
+				log.Printf("session %s ended (%v), closing attach stream", sessionID, exit)
+				return nil
+			case err != nil:
+				log.Printf("session %s: closing attach stream: %v", sessionID, err)
+				return status.Error(codes.ResourceExhausted, err.Error())
+			}
+			log.Printf("session %s detached, closing attach stream", sessionID)
+			return nil
+
+		case chunk := <-output:
+			pending = append(pending, chunk)
+
+		case n := <-credits:
+			window += int64(n)
+
+		case reply := <-replies:
+			if err := stream.Send(reply); err != nil {
+				return status.Errorf(codes.Internal, "error sending attach reply: %v", err)
+			}
+		}
+	}
+}
+
+// attachRecv applies the client's frames until the stream ends, with the
+// rights of the share token it opened the stream with. Replies are handed to
+// the sending loop, as only one goroutine may send on the stream.
+func (s *Service) attachRecv(stream pb.TerminalService_AttachServer, sessionID, shareToken string, credits chan<- uint64, replies chan<- *pb.AttachResponse) error {
+	ctx := stream.Context()
+	reply := func(resp *pb.AttachResponse) {
+		select {
+		case replies <- resp:
+		case <-ctx.Done():
+		}
+	}
+	fail := func(frame string, err error) {
+		msg := err.Error()
+		if st, ok := status.FromError(err); ok {
+			msg = st.Message()
+		}
+		reply(&pb.AttachResponse{Frame: &pb.AttachResponse_Error{Error: &pb.AttachError{Frame: frame, Message: msg}}})
+	}
+
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		switch frame := req.Frame.(type) {
+		case *pb.AttachRequest_Input:
+			access, err := s.authorize(sessionID, shareToken)
+			if err == nil {
+				err = s.manager.WriteInputAs(sessionID, access, frame.Input)
+			}
+			if err != nil {
+				log.Printf("error writing input to session %s: %v", sessionID, err)
+				fail("input", err)
+			}
+
+		case *pb.AttachRequest_Resize:
+			_, err := s.ResizeSession(ctx, &pb.ResizeSessionRequest{
+				SessionId:  sessionID,
+				Cols:       frame.Resize.GetCols(),
+				Rows:       frame.Resize.GetRows(),
+				ShareToken: shareToken,
+			})
+			if err != nil {
+				fail("resize", err)
+			}
+
+		case *pb.AttachRequest_Signal:
+			resp, err := s.SignalSession(ctx, &pb.SignalSessionRequest{SessionId: sessionID, Signal: frame.Signal, ShareToken: shareToken})
+			if err != nil {
+				fail("signal", err)
+				continue
+			}
+			reply(&pb.AttachResponse{Frame: &pb.AttachResponse_Signaled{Signaled: resp}})
+
+		case *pb.AttachRequest_Ack:
+			select {
+			case credits <- frame.Ack:
+			case <-ctx.Done():
+			}
+
+		case *pb.AttachRequest_Open:
+			fail("open", status.Error(codes.FailedPrecondition, "stream is already attached"))
+
+		default:
+			fail("unknown", status.Error(codes.InvalidArgument, "empty attach frame"))
+		}
+	}
+}
@@ -0,0 +1,422 @@
+package session
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	pb "github.com/entl/blockterm/gen/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var (
+	// ErrGroupNotFound is returned for a broadcast group ID that does not
+	// exist.
+	ErrGroupNotFound = errors.New("broadcast group not found")
+	// ErrInvalidGroupToken is returned for a group token that does not
+	// belong to the group.
+	ErrInvalidGroupToken = errors.New("invalid broadcast group token")
+	// ErrMemberBusy is reported for a group member whose terminal has not
+	// taken the group's earlier input yet; the input is dropped for it.
+	ErrMemberBusy = errors.New("terminal is not taking input")
+)
+
+// groupInputQueueLen is how many chunks of group input may wait for one
+// member before further input to it is dropped.
+const groupInputQueueLen = 64
+
+// BroadcastGroup is a set of sessions that receive the same input, for
+// typing into several panes at once.
+type BroadcastGroup struct {
+	ID         string
+	Name       string
+	SessionIDs []string
+	CreatedAt  time.Time
+
+	token   string
+	writers map[string]*memberWriter // by session ID
+}
+
+// GroupMember is a session to add to a broadcast group, with the access its
+// input is written with.
+type GroupMember struct {
+	SessionID string
+	Access    Access
+}
+
+// MemberError reports a group member that input could not be written to.
+// The other members still received it.
+type MemberError struct {
+	SessionID string
+	Err       error
+}
+
+// memberWriter writes a group's input to one member, in order and off the
+// caller's goroutine, so a terminal that is slow to take input only holds
+// up its own.
+type memberWriter struct {
+	access Access // what the group may do to the session
+	queue  chan []byte
+	done   chan struct{} // closed once the queue is drained after close
+}
+
+// startMemberWriter starts writing input queued for sessionID with access.
+// If prev is the member's writer being replaced, its queued input is
+// written first.
+func (m *Manager) startMemberWriter(sessionID string, access Access, prev *memberWriter) *memberWriter {
+	w := &memberWriter{
+		access: access,
+		queue:  make(chan []byte, groupInputQueueLen),
+		done:   make(chan struct{}),
+	}
+	go func() {
+		defer close(w.done)
+		if prev != nil {
+			<-prev.done
+		}
+		for data := range w.queue {
+			if err := m.WriteInputAs(sessionID, access, data); err != nil {
+				log.Printf("session %s: broadcast input failed: %v", sessionID, err)
+			}
+		}
+	}()
+	return w
+}
+
+// broadcastGroups holds a manager's groups.
+type broadcastGroups struct {
+	mu     sync.RWMutex
+	groups map[string]*BroadcastGroup
+}
+
+// CreateBroadcastGroup groups existing sessions for broadcast input and
+// returns the token that updating, deleting and sending input to the group
+// takes.
+func (m *Manager) CreateBroadcastGroup(name string, members []GroupMember) (BroadcastGroup, string, error) {
+	ids, access, err := m.groupMembers(members)
+	if err != nil {
+		return BroadcastGroup{}, "", err
+	}
+	token, err := newToken()
+	if err != nil {
+		return BroadcastGroup{}, "", fmt.Errorf("failed to generate group token: %w", err)
+	}
+
+	group := &BroadcastGroup{
+		ID:         uuid.New().String(),
+		Name:       name,
+		SessionIDs: ids,
+		CreatedAt:  time.Now(),
+		token:      token,
+		writers:    make(map[string]*memberWriter, len(ids)),
+	}
+	for _, id := range ids {
+		group.writers[id] = m.startMemberWriter(id, access[id], nil)
+	}
+
+	m.broadcast.mu.Lock()
+	if m.broadcast.groups == nil {
+		m.broadcast.groups = make(map[string]*BroadcastGroup)
+	}
+	m.broadcast.groups[group.ID] = group
+	m.broadcast.mu.Unlock()
+
+	return cloneGroup(group), token, nil
+}
+
+// SetBroadcastGroupMembers replaces the sessions in a group.
+func (m *Manager) SetBroadcastGroupMembers(groupID, token string, members []GroupMember) (BroadcastGroup, error) {
+	ids, access, err := m.groupMembers(members)
+	if err != nil {
+		return BroadcastGroup{}, err
+	}
+
+	m.broadcast.mu.Lock()
+	defer m.broadcast.mu.Unlock()
+
+	group, err := m.group(groupID, token)
+	if err != nil {
+		return BroadcastGroup{}, err
+	}
+	writers := make(map[string]*memberWriter, len(ids))
+	for _, id := range ids {
+		prev := group.writers[id]
+		if prev != nil {
+			close(prev.queue)
+			delete(group.writers, id)
+		}
+		writers[id] = m.startMemberWriter(id, access[id], prev)
+	}
+	for _, w := range group.writers {
+		close(w.queue)
+	}
+	group.SessionIDs = ids
+	group.writers = writers
+	return cloneGroup(group), nil
+}
+
+// DeleteBroadcastGroup removes a group. Its sessions are unaffected, and
+// input already queued for them is still written.
+func (m *Manager) DeleteBroadcastGroup(groupID, token string) error {
+	m.broadcast.mu.Lock()
+	defer m.broadcast.mu.Unlock()
+
+	group, err := m.group(groupID, token)
+	if err != nil {
+		return err
+	}
+	for _, w := range group.writers {
+		close(w.queue)
+	}
+	delete(m.broadcast.groups, groupID)
+	return nil
+}
+
+// removeFromGroups drops an ended session from every group it is in.
+func (m *Manager) removeFromGroups(sessionID string) {
+	m.broadcast.mu.Lock()
+	defer m.broadcast.mu.Unlock()
+
+	for _, group := range m.broadcast.groups {
+		w, ok := group.writers[sessionID]
+		if !ok {
+			continue
+		}
+		close(w.queue)
+		delete(group.writers, sessionID)
+		group.SessionIDs = slices.DeleteFunc(group.SessionIDs, func(id string) bool { return id == sessionID })
+	}
+}
+
+// ListBroadcastGroups returns every group, oldest first.
+func (m *Manager) ListBroadcastGroups() []BroadcastGroup {
+	m.broadcast.mu.RLock()
+	groups := make([]BroadcastGroup, 0, len(m.broadcast.groups))
+	for _, group := range m.broadcast.groups {
+		groups = append(groups, cloneGroup(group))
+	}
+	m.broadcast.mu.RUnlock()
+
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].CreatedAt.Before(groups[j].CreatedAt)
+	})
+	return groups
+}
+
+// WriteGroupInput queues data for every session in a group, to be written
+// with the access each was added with. Each member's input is written in
+// order on its own goroutine, so a slow terminal does not hold up the
+// others; once groupInputQueueLen chunks wait for one, further input to it
+// is dropped and reported as ErrMemberBusy. Members that cannot take input,
+// such as sessions whose share was revoked, are reported too. It returns
+// how many members the input was queued for. data is written after
+// WriteGroupInput returns and must not be changed.
+func (m *Manager) WriteGroupInput(groupID, token string, data []byte) (int, []MemberError, error) {
+	m.broadcast.mu.RLock()
+	defer m.broadcast.mu.RUnlock()
+
+	group, err := m.group(groupID, token)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	delivered := 0
+	var failures []MemberError
+	for _, sessionID := range group.SessionIDs {
+		w := group.writers[sessionID]
+		if err := w.access.CheckWrite(); err != nil {
+			failures = append(failures, MemberError{SessionID: sessionID, Err: err})
+			continue
+		}
+		select {
+		case w.queue <- data:
+			delivered++
+		default:
+			failures = append(failures, MemberError{SessionID: sessionID, Err: ErrMemberBusy})
+		}
+	}
+	return delivered, failures, nil
+}
+
+// group looks up a group for a client presenting token. The caller must
+// hold m.broadcast.mu.
+func (m *Manager) group(groupID, token string) (*BroadcastGroup, error) {
+	group, ok := m.broadcast.groups[groupID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrGroupNotFound, groupID)
+	}
+	if subtle.ConstantTimeCompare([]byte(group.token), []byte(token)) != 1 {
+		return nil, ErrInvalidGroupToken
+	}
+	return group, nil
+}
+
+// groupMembers validates the members of a group, dropping duplicates. Each
+// must be a session its access may write to.
+func (m *Manager) groupMembers(members []GroupMember) ([]string, map[string]Access, error) {
+	if len(members) == 0 {
+		return nil, nil, fmt.Errorf("a broadcast group needs at least one session")
+	}
+
+	var ids []string
+	access := make(map[string]Access, len(members))
+	for _, member := range members {
+		if _, ok := access[member.SessionID]; ok {
+			continue
+		}
+		if _, err := m.GetSession(member.SessionID); err != nil {
+			return nil, nil, err
+		}
+		if err := member.Access.CheckWrite(); err != nil {
+			return nil, nil, fmt.Errorf("session %s: %w", member.SessionID, err)
+		}
+		ids = append(ids, member.SessionID)
+		access[member.SessionID] = member.Access
+	}
+	return ids, access, nil
+}
+
+// cloneGroup copies a group's description so callers cannot change the
+// manager's. The token and member writers stay with the manager.
+func cloneGroup(g *BroadcastGroup) BroadcastGroup {
+	return BroadcastGroup{
+		ID:         g.ID,
+		Name:       g.Name,
+		SessionIDs: slices.Clone(g.SessionIDs),
+		CreatedAt:  g.CreatedAt,
+	}
+}
+
+// CreateBroadcastGroup groups sessions for SendGroupInput.
+func (s *Service) CreateBroadcastGroup(ctx context.Context, req *pb.CreateBroadcastGroupRequest) (*pb.BroadcastGroup, error) {
+	members, err := s.groupMembers(req.SessionIds, req.ShareTokens)
+	if err != nil {
+		return nil, err
+	}
+
+	group, token, err := s.manager.CreateBroadcastGroup(req.Name, members)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	resp := toProtoGroup(group)
+	resp.GroupToken = token
+	return resp, nil
+}
+
+// UpdateBroadcastGroup replaces the sessions in a group.
+func (s *Service) UpdateBroadcastGroup(ctx context.Context, req *pb.BroadcastGroup) (*pb.BroadcastGroup, error) {
+	if req.GroupId == "" {
+		return nil, status.Error(codes.InvalidArgument, "group_id is required")
+	}
+
+	members, err := s.groupMembers(req.SessionIds, req.ShareTokens)
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := s.manager.SetBroadcastGroupMembers(req.GroupId, req.GroupToken, members)
+	if err != nil {
+		return nil, groupError(err, codes.InvalidArgument)
+	}
+	return toProtoGroup(group), nil
+}
+
+// DeleteBroadcastGroup removes a group.
+func (s *Service) DeleteBroadcastGroup(ctx context.Context, req *pb.DeleteBroadcastGroupRequest) (*pb.Ack, error) {
+	if err := s.manager.DeleteBroadcastGroup(req.GroupId, req.GroupToken); err != nil {
+		return nil, groupError(err, codes.Internal)
+	}
+	return &pb.Ack{Ok: true}, nil
+}
+
+// ListBroadcastGroups returns every group.
+func (s *Service) ListBroadcastGroups(ctx context.Context, _ *emptypb.Empty) (*pb.ListBroadcastGroupsResponse, error) {
+	groups := s.manager.ListBroadcastGroups()
+	resp := &pb.ListBroadcastGroupsResponse{Groups: make([]*pb.BroadcastGroup, 0, len(groups))}
+	for _, group := range groups {
+		resp.Groups = append(resp.Groups, toProtoGroup(group))
+	}
+	return resp, nil
+}
+
+// SendGroupInput writes each chunk to every session in its group and
+// answers it with the members that could not take it. Unlike SendInput, a
+// failed write does not end the stream.
+func (s *Service) SendGroupInput(stream pb.TerminalService_SendGroupInputServer) error {
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			log.Printf("error receiving group input: %v", err)
+			return status.Errorf(codes.Internal, "error receiving input: %v", err)
+		}
+
+		result := &pb.GroupInputResult{GroupId: chunk.GroupId, Seq: chunk.Seq}
+		delivered, failures, err := s.manager.WriteGroupInput(chunk.GroupId, chunk.GroupToken, chunk.Data)
+		if err != nil {
+			result.Error = err.Error()
+		}
+		result.Delivered = uint32(delivered)
+		for _, f := range failures {
+			log.Printf("session %s: broadcast input failed: %v", f.SessionID, f.Err)
+			result.Failures = append(result.Failures, &pb.MemberFailure{SessionId: f.SessionID, Error: f.Err.Error()})
+		}
+
+		if err := stream.Send(result); err != nil {
+			log.Printf("error sending group input result: %v", err)
+			return status.Errorf(codes.Internal, "error sending result: %v", err)
+		}
+	}
+}
+
+// groupMembers authorizes the sessions of a group request with their
+// tokens. Each must be one that may write to the session.
+func (s *Service) groupMembers(sessionIDs []string, tokens map[string]string) ([]GroupMember, error) {
+	members := make([]GroupMember, 0, len(sessionIDs))
+	for _, id := range sessionIDs {
+		access, err := s.authorize(id, tokens[id])
+		if err != nil {
+			return nil, err
+		}
+		if err := access.CheckWrite(); err != nil {
+			return nil, status.Errorf(codes.PermissionDenied, "session %s: %v", id, err)
+		}
+		members = append(members, GroupMember{SessionID: id, Access: access})
+	}
+	return members, nil
+}
+
+// groupError converts an error from looking up a group into a gRPC status,
+// using code for anything but an unknown group or a wrong token.
+func groupError(err error, code codes.Code) error {
+	switch {
+	case errors.Is(err, ErrGroupNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, ErrInvalidGroupToken):
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return status.Error(code, err.Error())
+}
+
+// toProtoGroup converts a broadcast group into its wire form.
+func toProtoGroup(g BroadcastGroup) *pb.BroadcastGroup {
+	return &pb.BroadcastGroup{
+		GroupId:    g.ID,
+		Name:       g.Name,
+		SessionIds: g.SessionIDs,
+		CreatedAt:  g.CreatedAt.Unix(),
+	}
+}
@@ -0,0 +1,185 @@
+package session
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"syscall"
+	"time"
+)
+
+const (
+	// defaultCloseGracePeriod is how long CloseSession waits after SIGHUP,
+	// and again after SIGTERM, for the session's processes to exit.
+	defaultCloseGracePeriod = 2 * time.Second
+	// killSettleTimeout is how long processes get to die after SIGKILL
+	// before they are reported as having survived.
+	killSettleTimeout = time.Second
+	// closePollInterval is how often CloseSession checks what is left.
+	closePollInterval = 50 * time.Millisecond
+)
+
+// KilledProcess reports a process that CloseSession terminated.
+type KilledProcess struct {
+	ProcessInfo
+	Signal   string // last signal it was sent before it ended
+	Survived bool   // still alive after SIGKILL
+}
+
+// closeTarget is a process CloseSession is waiting on.
+type closeTarget struct {
+	st     procStat
+	report KilledProcess
+	gone   bool
+}
+
+// SetCloseGracePeriod changes how long CloseSession waits at each step of
+// its escalation when the caller does not say.
+func (m *Manager) SetCloseGracePeriod(d time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.closeGrace = d
+}
+
+// CloseSession hangs up a session and terminates every process it started.
+// The shell's process group and the terminal's foreground group get SIGHUP
+// first; whatever is left after grace gets SIGTERM, and after another grace
+// period SIGKILL. A grace of zero uses the manager's default. It returns the
+// processes that were running when the session was closed and how each one
+// ended.
+func (m *Manager) CloseSession(sessionID string, grace time.Duration) ([]KilledProcess, error) {
+	m.mu.Lock()
+	session, ok := m.sessions[sessionID]
+	if !ok {
+		m.mu.Unlock()
+		return nil, fmt.Errorf("%w: %s", ErrSessionNotFound, sessionID)
+	}
+	delete(m.sessions, sessionID)
+	if grace <= 0 {
+		grace = m.closeGrace
+	}
+	m.mu.Unlock()
+	m.removeFromGroups(sessionID)
+
+	// Take what the hangup needs under the lock and do the slow part, the
+	// /proc walks and the signalling, without it; once the session is
+	// marked closed nothing else closes its terminal.
+	session.mu.Lock()
+	if session.State == StateClosed {
+		session.mu.Unlock()
+		return nil, nil // already closed
+	}
+	session.State = StateClosed
+
+	shellPID := 0
+	if proc, ok := session.Process.(*os.Process); ok {
+		shellPID = proc.Pid
+	}
+	var groups []int
+	if shellPID != 0 {
+		groups = append(groups, shellPID)
+	}
+	if session.PTY != nil {
+		if pgid, err := foregroundProcessGroup(session.PTY); err == nil && pgid > 0 && pgid != shellPID {
+			groups = append(groups, pgid)
+		}
+	}
+	ptmx, initCleanup := session.PTY, session.initCleanup
+	session.mu.Unlock()
+
+	// Find everything before the hangup starts reparenting orphans.
+	var targets []*closeTarget
+	seen := make(map[int]bool)
+	discover := func() {
+		if shellPID == 0 {
+			return
+		}
+		for _, st := range sessionProcesses(shellPID) {
+			if seen[st.PID] || !procAlive(st) {
+				continue
+			}
+			seen[st.PID] = true
+			targets = append(targets, &closeTarget{st: st, report: KilledProcess{ProcessInfo: processInfo(st.PID)}})
+		}
+	}
+	discover()
+
+	escalate(targets, "SIGHUP", syscall.SIGHUP, func() {
+		for _, pgid := range groups {
+			if err := signalProcessGroup(pgid, syscall.SIGHUP); err != nil {
+				log.Printf("session %s: failed to hang up process group %d: %v", sessionID, pgid, err)
+			}
+		}
+	})
+
+	if ptmx != nil {
+		ptmx.Close()
+	}
+	if initCleanup != nil {
+		initCleanup()
+	}
+
+	if !waitGone(targets, grace) {
+		discover() // jobs started by traps while hanging up
+		escalate(targets, "SIGTERM", syscall.SIGTERM, nil)
+		if !waitGone(targets, grace) {
+			discover()
+			escalate(targets, "SIGKILL", syscall.SIGKILL, nil)
+			waitGone(targets, killSettleTimeout)
+		}
+	}
+
+	killed := make([]KilledProcess, 0, len(targets))
+	for _, t := range targets {
+		t.report.Survived = !t.gone
+		if t.report.Survived {
+			log.Printf("session %s: process %d (%s) survived SIGKILL", sessionID, t.st.PID, t.report.Name)
+		}
+		killed = append(killed, t.report)
+	}
+	return killed, nil
+}
+
+// escalate records that sig is being sent to the targets still alive and
+// sends it, either with send or, if send is nil, to each of them directly.
+func escalate(targets []*closeTarget, name string, sig syscall.Signal, send func()) {
+	for _, t := range targets {
+		if t.gone {
+			continue
+		}
+		t.report.Signal = name
+		if send == nil && procAlive(t.st) {
+			if proc, err := os.FindProcess(t.st.PID); err == nil {
+				proc.Signal(sig)
+			}
+		}
+	}
+	if send != nil {
+		send()
+	}
+}
+
+// waitGone polls the targets until all have exited or timeout passes, and
+// reports whether they all exited.
+func waitGone(targets []*closeTarget, timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for {
+		alive := 0
+		for _, t := range targets {
+			if !t.gone && !procAlive(t.st) {
+				t.gone = true
+			}
+			if !t.gone {
+				alive++
+			}
+		}
+		if alive == 0 {
+			return true
+		}
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(closePollInterval)
+	}
+}
@@ -0,0 +1,164 @@
+package session
+
+import (
+	"bytes"
+	"net/url"
+	"os"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+// OSC 7 ("current working directory") framing as emitted by the shell
+// integration: ESC ] 7 ; file://host/path, terminated by BEL or ST (ESC \).
+const (
+	osc7Prefix = "\x1b]7;"
+	oscBEL     = "\x07"
+	oscST      = "\x1b\\"
+
+	// maxCwdHistory bounds the per-session directory history.
+	maxCwdHistory = 100
+)
+
+// CwdChange records a working directory reported by the shell.
+type CwdChange struct {
+	Path string
+	Time time.Time
+}
+
+// osc7Scanner watches the raw PTY stream for OSC 7 sequences. It only
+// observes; the bytes are still delivered to clients unchanged. Sequences
+// split across reads are reassembled from a bounded tail buffer.
+type osc7Scanner struct {
+	pending []byte
+}
+
+// Scan consumes the next slice of raw PTY output and returns every
+// directory reported in it, in order.
+func (s *osc7Scanner) Scan(data []byte) []string {
+	buf := append(s.pending, data...)
+	s.pending = nil
+
+	var dirs []string
+	for len(buf) > 0 {
+		idx := bytes.Index(buf, []byte(osc7Prefix))
+		if idx == -1 {
+			keep := partialSuffixLen(buf, osc7Prefix)
+			s.pending = append(s.pending, buf[len(buf)-keep:]...)
+			break
+		}
+
+		body := buf[idx+len(osc7Prefix):]
+		end, termLen := oscTerminator(body)
+		if end == -1 {
+			if len(body) > maxMarkerLen {
+				buf = body // never terminated; keep scanning past it
+				continue
+			}
+			s.pending = append(s.pending, buf[idx:]...)
+			break
+		}
+
+		if dir, ok := decodeOsc7(string(body[:end])); ok {
+			dirs = append(dirs, dir)
+		}
+		buf = body[end+termLen:]
+	}
+	return dirs
+}
+
+// oscTerminator finds the first BEL or ST in body and returns its index and
+// length, or -1 if the sequence is not terminated yet.
+func oscTerminator(body []byte) (int, int) {
+	bel := bytes.Index(body, []byte(oscBEL))
+	st := bytes.Index(body, []byte(oscST))
+	switch {
+	case bel == -1 && st == -1:
+		return -1, 0
+	case st == -1 || (bel != -1 && bel < st):
+		return bel, len(oscBEL)
+	default:
+		return st, len(oscST)
+	}
+}
+
+// decodeOsc7 extracts the directory from an OSC 7 payload. Both the
+// "file://host/path" URL form and a bare "/path" are accepted, and
+// percent-encoding is decoded. Reports from another host, such as a shell
+// reached over ssh, and paths that are not valid escaping are rejected.
+func decodeOsc7(payload string) (string, bool) {
+	if rest, ok := strings.CutPrefix(payload, "file://"); ok {
+		slash := strings.IndexByte(rest, '/')
+		if slash == -1 || !isLocalHost(rest[:slash]) {
+			return "", false
+		}
+		payload = rest[slash:]
+	}
+
+	if !strings.HasPrefix(payload, "/") {
+		return "", false
+	}
+	dir, err := url.PathUnescape(payload)
+	if err != nil {
+		return "", false
+	}
+	if runtime.GOOS == "windows" && len(dir) >= 3 && dir[2] == ':' {
+		dir = dir[1:] // "/C:/Users" names the drive path "C:/Users"
+	}
+	return dir, true
+}
+
+// localHostname is this machine's name, as OSC 7 reports from it carry.
+var localHostname = sync.OnceValue(func() string {
+	name, _ := os.Hostname()
+	return name
+})
+
+// isLocalHost reports whether the host of an OSC 7 URL is this machine.
+// Shells name it differently (HOSTNAME, COMPUTERNAME), so case and the
+// domain are ignored.
+func isLocalHost(host string) bool {
+	if host == "" || strings.EqualFold(host, "localhost") {
+		return true
+	}
+	short := func(name string) string {
+		name, _, _ = strings.Cut(name, ".")
+		return name
+	}
+	local := localHostname()
+	return local != "" && strings.EqualFold(short(host), short(local))
+}
+
+// setCwd updates the session's working directory and appends it to the
+// directory history. Repeated reports of the same directory (one per
+// prompt) are collapsed.
+func (s *Session) setCwd(dir string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.Cwd == dir && len(s.cwdHistory) > 0 {
+		return
+	}
+	s.Cwd = dir
+	s.cwdHistory = append(s.cwdHistory, CwdChange{Path: dir, Time: time.Now()})
+	if len(s.cwdHistory) > maxCwdHistory {
+		s.cwdHistory = s.cwdHistory[len(s.cwdHistory)-maxCwdHistory:]
+	}
+}
+
+// CurrentCwd returns the session's current working directory.
+func (s *Session) CurrentCwd() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.Cwd
+}
+
+// CwdHistory returns the directories the session has visited, oldest first.
+func (s *Session) CwdHistory() []CwdChange {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return append([]CwdChange(nil), s.cwdHistory...)
+}
@@ -0,0 +1,29 @@
+package session
+
+import "testing"
+
+func TestDecodeOsc7(t *testing.T) {
+	host := localHostname()
+	tests := []struct {
+		payload string
+		dir     string
+		ok      bool
+	}{
+		{"file://" + host + "/home/me", "/home/me", true},
+		{"file:///home/me", "/home/me", true},
+		{"file://localhost/home/me", "/home/me", true},
+		{"/home/me", "/home/me", true},
+		{"file://" + host + "/tmp/a%20b/100%25", "/tmp/a b/100%", true},
+		{"file://" + host + "/caf%C3%A9", "/café", true},
+		{"file://elsewhere.example.com/home/me", "", false},
+		{"file://" + host + "/tmp/100%", "", false}, // not valid escaping
+		{"file://" + host, "", false},
+		{"home/me", "", false},
+	}
+	for _, tt := range tests {
+		dir, ok := decodeOsc7(tt.payload)
+		if dir != tt.dir || ok != tt.ok {
+			t.Errorf("decodeOsc7(%q) = %q, %v; want %q, %v", tt.payload, dir, ok, tt.dir, tt.ok)
+		}
+	}
+}
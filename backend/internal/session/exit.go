@@ -0,0 +1,93 @@
+package session
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"syscall"
+	"time"
+)
+
+// outputDrainTimeout bounds how long a session waits, once its shell has
+// exited, for the reader to pass on the last of the output. A background
+// job can keep the terminal open indefinitely.
+const outputDrainTimeout = 2 * time.Second
+
+// ExitStatus describes how a session's shell ended.
+type ExitStatus struct {
+	Code         int    // exit code; 128+n if killed by signal n, as shells report it
+	Signal       string // terminating signal (e.g. "SIGKILL"), or "" if the shell exited
+	ClosedByUser bool   // the session was ended with CloseSession
+	Reaped       string // ReapIdle or ReapLifetime if a limit closed the session
+}
+
+func (e ExitStatus) String() string {
+	switch {
+	case e.Reaped != "":
+		return fmt.Sprintf("closed by %s limit", e.Reaped)
+	case e.ClosedByUser:
+		return "closed"
+	case e.Signal != "":
+		return fmt.Sprintf("killed by %s", e.Signal)
+	default:
+		return fmt.Sprintf("exited with %d", e.Code)
+	}
+}
+
+// ExitError ends every subscription of a session whose shell has exited.
+type ExitError struct {
+	Status ExitStatus
+	Offset int64 // end of the session's output stream
+}
+
+func (e *ExitError) Error() string {
+	return "shell " + e.Status.String()
+}
+
+// Done is closed once the session's shell has exited and all its output
+// has been delivered to subscribers.
+func (s *Session) Done() <-chan struct{} {
+	return s.done
+}
+
+// Exit returns how the shell ended; it is only meaningful once Done is
+// closed.
+func (s *Session) Exit() ExitStatus {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.exit
+}
+
+// exitStatusOf derives an ExitStatus from a waited-for process.
+func exitStatusOf(state *os.ProcessState) ExitStatus {
+	if state == nil {
+		return ExitStatus{Code: -1}
+	}
+	if ws, ok := state.Sys().(syscall.WaitStatus); ok && ws.Signaled() {
+		sig := ws.Signal()
+		return ExitStatus{Code: 128 + int(sig), Signal: signalName(sig)}
+	}
+	return ExitStatus{Code: state.ExitCode()}
+}
+
+// finish ends the session's subscriptions with exit and closes Done. Output
+// published earlier is still queued for them, ahead of the end.
+func (s *Session) finish(exit ExitStatus) {
+	s.outputMu.Lock()
+	s.exitErr = &ExitError{Status: exit, Offset: s.scrollback.end}
+	subs := append([]*Subscription(nil), s.subscribers...)
+	s.outputMu.Unlock()
+
+	for _, sub := range subs {
+		sub.closeWith(s.exitErr)
+	}
+	close(s.done)
+	log.Printf("session %s: shell %s", s.ID, exit)
+}
+
+// exitChunk is the final chunk of a stream whose session ended.
+func exitChunk(sessionID string, exit *ExitError) OutputChunk {
+	status := exit.Status
+	return OutputChunk{SessionID: sessionID, Offset: exit.Offset, Exit: &status}
+}
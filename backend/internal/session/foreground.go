@@ -0,0 +1,124 @@
+package session
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"time"
+)
+
+// foregroundPollInterval is how often the terminal's foreground process
+// group is sampled. Programs can take over the terminal without writing
+// anything (e.g. `sleep`), so output alone is not a reliable trigger.
+const foregroundPollInterval = 250 * time.Millisecond
+
+// DEC private mode switches to and from the alternate screen buffer, as
+// used by full-screen programs (vim, less, htop): CSI ? 1049 h/l, and the
+// older 47 and 1047 variants.
+const (
+	csiPrivatePrefix = "\x1b[?"
+
+	// maxCSILen bounds how long an unterminated CSI sequence is held back.
+	maxCSILen = 32
+)
+
+var altScreenModes = []string{"1049", "1047", "47"}
+
+// ProcessInfo describes a process in a session's terminal.
+type ProcessInfo struct {
+	PID  int
+	Name string
+	Argv []string
+}
+
+// StateChange reports what owns a session's terminal. It is delivered on
+// the output stream as a data-less chunk whenever either part changes.
+type StateChange struct {
+	Foreground ProcessInfo // leader of the foreground process group
+	AltScreen  bool        // true while a full-screen program is active
+}
+
+// altScreenScanner watches the raw PTY stream for alternate-screen mode
+// switches. Like osc7Scanner it only observes, and reassembles sequences
+// split across reads.
+type altScreenScanner struct {
+	pending []byte
+}
+
+// Scan consumes the next slice of raw PTY output. It reports the screen the
+// data leaves the terminal on, and ok=false if the data contains no switch.
+func (s *altScreenScanner) Scan(data []byte) (alt bool, ok bool) {
+	buf := append(s.pending, data...)
+	s.pending = nil
+
+	for len(buf) > 0 {
+		idx := bytes.Index(buf, []byte(csiPrivatePrefix))
+		if idx == -1 {
+			keep := partialSuffixLen(buf, csiPrivatePrefix)
+			s.pending = append(s.pending, buf[len(buf)-keep:]...)
+			break
+		}
+
+		body := buf[idx+len(csiPrivatePrefix):]
+		end := bytes.IndexFunc(body, func(r rune) bool {
+			return (r < '0' || r > '9') && r != ';'
+		})
+		if end == -1 {
+			if len(body) < maxCSILen {
+				s.pending = append(s.pending, buf[idx:]...)
+			}
+			break
+		}
+
+		if final := body[end]; final == 'h' || final == 'l' {
+			for _, mode := range strings.Split(string(body[:end]), ";") {
+				if slices.Contains(altScreenModes, mode) {
+					alt, ok = final == 'h', true
+				}
+			}
+		}
+		buf = body[end:]
+	}
+	return alt, ok
+}
+
+// watchForeground samples the session's foreground process group until the
+// session ends, publishing a state change whenever it moves.
+func (m *Manager) watchForeground(session *Session) {
+	ticker := time.NewTicker(foregroundPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-session.done:
+			return
+		case <-ticker.C:
+			m.updateState(session, nil)
+		}
+	}
+}
+
+// updateState refreshes the session's foreground process and, if altScreen
+// is non-nil, its alternate-screen flag. A state change chunk is published
+// if anything differs from what clients last saw.
+func (m *Manager) updateState(session *Session, altScreen *bool) {
+	session.mu.Lock()
+	ptmx := session.PTY
+	changed := false
+	if altScreen != nil && *altScreen != session.altScreen {
+		session.altScreen = *altScreen
+		changed = true
+	}
+	if ptmx != nil && session.State == StateRunning {
+		if pgid, err := foregroundProcessGroup(ptmx); err == nil && pgid != session.foreground.PID {
+			session.foreground = processInfo(pgid)
+			changed = true
+		}
+	}
+	state := StateChange{Foreground: session.foreground, AltScreen: session.altScreen}
+	session.mu.Unlock()
+
+	if changed {
+		m.publish(session, []OutputChunk{{State: &state}})
+	}
+}
@@ -0,0 +1,243 @@
+package session
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	pb "github.com/entl/blockterm/gen/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// ErrTooManySessions is returned by StartSession when Limits.MaxSessions
+// sessions are already running.
+var ErrTooManySessions = errors.New("too many sessions")
+
+// limitCheckInterval is how often idle and lifetime limits are checked.
+const limitCheckInterval = time.Second
+
+// eventQueueLen bounds the events queued for one WatchEvents caller.
+const eventQueueLen = 64
+
+// Limits bounds the sessions a Manager runs. A zero field disables that
+// limit.
+type Limits struct {
+	MaxSessions int           // sessions running at once
+	IdleTimeout time.Duration // close detached sessions without input or output for this long
+	MaxLifetime time.Duration // close sessions this long after they started
+	WarnBefore  time.Duration // how long before closing a session to warn about it
+}
+
+// DefaultLimits are the limits the server runs with unless configured
+// otherwise: none, so sessions run until they are closed. WarnBefore
+// applies once a limit is set.
+var DefaultLimits = Limits{
+	WarnBefore: 5 * time.Minute,
+}
+
+// Kinds of SessionEvent.
+const (
+	EventIdleWarning     = "idle_warning"     // a detached session will be closed for inactivity
+	EventLifetimeWarning = "lifetime_warning" // a session will be closed for reaching its maximum lifetime
+	EventReaped          = "reaped"           // a session was closed by a limit
+)
+
+// Reasons a session is reaped, reported in SessionEvent.Reason and
+// ExitStatus.Reaped.
+const (
+	ReapIdle     = "idle"
+	ReapLifetime = "lifetime"
+)
+
+// SessionEvent reports what the limits are about to do, or did, to a
+// session. Warnings are also delivered on the session's output stream.
+type SessionEvent struct {
+	SessionID string
+	Kind      string
+	Reason    string      // ReapIdle or ReapLifetime
+	Deadline  time.Time   // when the session will be, or was, closed
+	Trigger   *TriggerHit // for EventTrigger
+}
+
+// limitState is what a session tracks for the limits.
+type limitState struct {
+	activity     time.Time // last input or output
+	warnedFor    time.Time // deadline the last warning was sent for
+	reapedReason string    // set once a limit closes the session
+}
+
+// SetLimits replaces the manager's limits. Sessions already running beyond
+// MaxSessions are left alone; the others apply from the next check.
+func (m *Manager) SetLimits(limits Limits) {
+	m.mu.Lock()
+	m.limits = limits
+	m.mu.Unlock()
+
+	m.limitsOnce.Do(func() { go m.enforceLimits() })
+}
+
+// reserveSession claims a slot under MaxSessions for a session about to
+// start. The caller must call release once the session is in the map, or
+// has failed to start.
+func (m *Manager) reserveSession() (release func(), err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if limit := m.limits.MaxSessions; limit > 0 && len(m.sessions)+m.starting >= limit {
+		return nil, fmt.Errorf("%w: limit is %d", ErrTooManySessions, limit)
+	}
+	m.starting++
+	return func() {
+		m.mu.Lock()
+		m.starting--
+		m.mu.Unlock()
+	}, nil
+}
+
+// touch records input or output on the session, restarting its idle clock.
+func (s *Session) touch(t time.Time) {
+	s.limitMu.Lock()
+	s.limit.activity = t
+	s.limitMu.Unlock()
+}
+
+// enforceLimits periodically warns about and closes sessions that run into
+// the idle or lifetime limit, until the manager is closed.
+func (m *Manager) enforceLimits() {
+	ticker := time.NewTicker(limitCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-m.stop:
+			return
+		case now := <-ticker.C:
+			m.mu.RLock()
+			limits := m.limits
+			m.mu.RUnlock()
+
+			for _, session := range m.ListSessions() {
+				m.checkLimits(session, limits, now)
+			}
+		}
+	}
+}
+
+// checkLimits warns about or closes one session.
+func (m *Manager) checkLimits(session *Session, limits Limits, now time.Time) {
+	session.mu.RLock()
+	running := session.State == StateRunning
+	createdAt, detachedAt := session.CreatedAt, session.DetachedAt
+	session.mu.RUnlock()
+	if !running {
+		return
+	}
+
+	session.limitMu.Lock()
+	var reason string
+	var deadline time.Time
+	if limits.MaxLifetime > 0 {
+		reason, deadline = ReapLifetime, createdAt.Add(limits.MaxLifetime)
+	}
+	if limits.IdleTimeout > 0 && !detachedAt.IsZero() {
+		idleSince := detachedAt
+		if session.limit.activity.After(idleSince) {
+			idleSince = session.limit.activity
+		}
+		if idle := idleSince.Add(limits.IdleTimeout); deadline.IsZero() || idle.Before(deadline) {
+			reason, deadline = ReapIdle, idle
+		}
+	}
+
+	var event *SessionEvent
+	switch {
+	case deadline.IsZero() || session.limit.reapedReason != "":
+	case !now.Before(deadline):
+		session.limit.reapedReason = reason
+		event = &SessionEvent{SessionID: session.ID, Kind: EventReaped, Reason: reason, Deadline: deadline}
+	case !now.Before(deadline.Add(-limits.WarnBefore)) && !session.limit.warnedFor.Equal(deadline):
+		session.limit.warnedFor = deadline
+		kind := EventIdleWarning
+		if reason == ReapLifetime {
+			kind = EventLifetimeWarning
+		}
+		event = &SessionEvent{SessionID: session.ID, Kind: kind, Reason: reason, Deadline: deadline}
+	}
+	session.limitMu.Unlock()
+
+	if event == nil {
+		return
+	}
+	if event.Kind != EventReaped {
+		log.Printf("session %s: will be closed at %s (%s)", session.ID, deadline.Format(time.RFC3339), reason)
+		m.publish(session, []OutputChunk{{Event: event}})
+		m.emitEvent(*event)
+		return
+	}
+
+	log.Printf("session %s: closing, %s limit reached", session.ID, reason)
+	m.emitEvent(*event)
+	// Closing waits out the grace period; keep checking the others.
+	go func() {
+		if _, err := m.CloseSession(session.ID, 0); err != nil {
+			log.Printf("session %s: failed to close: %v", session.ID, err)
+		}
+	}()
+}
+
+// WatchEvents returns a channel receiving every SessionEvent from now on,
+// and a function that stops the watch and closes the channel. Events are
+// dropped for a watcher that falls behind.
+func (m *Manager) WatchEvents() (<-chan SessionEvent, func()) {
+	ch := make(chan SessionEvent, eventQueueLen)
+
+	m.eventsMu.Lock()
+	m.eventWatchers[ch] = struct{}{}
+	m.eventsMu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			m.eventsMu.Lock()
+			delete(m.eventWatchers, ch)
+			m.eventsMu.Unlock()
+			close(ch)
+		})
+	}
+}
+
+// emitEvent delivers an event to every watcher that has room for it.
+func (m *Manager) emitEvent(event SessionEvent) {
+	m.eventsMu.Lock()
+	defer m.eventsMu.Unlock()
+
+	for ch := range m.eventWatchers {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
+
+// WatchSessionEvents streams limit warnings and reaps for every session
+// until the client goes away.
+func (s *Service) WatchSessionEvents(_ *emptypb.Empty, stream pb.TerminalService_WatchSessionEventsServer) error {
+	events, stop := s.manager.WatchEvents()
+	defer stop()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case event := <-events:
+			if err := stream.Send(toProtoEvent(event)); err != nil {
+				log.Printf("error streaming session events: %v", err)
+				return status.Errorf(codes.Internal, "error streaming events: %v", err)
+			}
+		}
+	}
+}
@@ -1,31 +1,79 @@
 package session
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"log"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/creack/pty"
 	"github.com/google/uuid"
+
+	"github.com/entl/blockterm/internal/recording"
 )
 
+// CommandRecorder persists commands observed through the shell
+// integration. It is satisfied by *history.Service.
+type CommandRecorder interface {
+	// RecordCommandStart stores a command as it starts and returns a
+	// channel yielding its ID once stored (nil if nothing was recorded).
+	RecordCommandStart(sessionID, shell, cwd, cmdText string) <-chan int64
+	// UpdateExitCode completes a stored command.
+	UpdateExitCode(cmdID int64, exitCode int, duration time.Duration) error
+}
+
+// ErrSessionNotFound is returned for a session ID that is unknown, or
+// whose session has ended.
+var ErrSessionNotFound = errors.New("session not found")
+
 // Manager manages multiple PTY sessions.
 type Manager struct {
-	sessions map[string]*Session
-	mu       sync.RWMutex
+	sessions   map[string]*Session
+	mu         sync.RWMutex
+	recorder   CommandRecorder
+	recordings *recording.Store
+	closeGrace time.Duration // see SetCloseGracePeriod
+
+	limits     Limits
+	starting   int // sessions reserved under MaxSessions but not yet in sessions
+	limitsOnce sync.Once
+	stop       chan struct{} // closed by Close
+	stopOnce   sync.Once
+
+	eventsMu      sync.Mutex
+	eventWatchers map[chan SessionEvent]struct{}
+
+	broadcast broadcastGroups
+
+	triggers []Trigger // see SetTriggers
 }
 
 // NewManager creates a new session manager.
 func NewManager() *Manager {
 	return &Manager{
-		sessions: make(map[string]*Session),
+		sessions:      make(map[string]*Session),
+		closeGrace:    defaultCloseGracePeriod,
+		stop:          make(chan struct{}),
+		eventWatchers: make(map[chan SessionEvent]struct{}),
 	}
 }
 
+// SetCommandRecorder makes the manager record every command the shell
+// integration reports, with its exit code and duration, to r.
+func (m *Manager) SetCommandRecorder(r CommandRecorder) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.recorder = r
+}
+
 // StartSession creates and starts a new PTY session.
 func (m *Manager) StartSession(opts SessionOptions) (*Session, error) {
 	// Apply defaults
@@ -35,46 +83,78 @@ func (m *Manager) StartSession(opts SessionOptions) (*Session, error) {
 	if opts.Rows == 0 {
 		return nil, fmt.Errorf("rows must be greater than 0")
 	}
-	if opts.Shell == "" {
+	if len(opts.Command) > 0 && (opts.Shell != "" || len(opts.Args) > 0 || opts.Login || len(opts.StartupCommands) > 0) {
+		return nil, fmt.Errorf("command cannot be combined with shell, args, login or startup commands")
+	}
+	if opts.Shell == "" && len(opts.Command) == 0 {
 		opts.Shell = defaultShell()
 	}
 	if opts.Cwd == "" {
 		opts.Cwd = homeDir()
 	}
+	protocol, err := ParseMarkerProtocol(string(opts.MarkerProtocol))
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := newMarkerNonce()
+	if err != nil {
+		return nil, err
+	}
+	ownerToken, err := newToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate owner token: %w", err)
+	}
+	release, err := m.reserveSession()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
 
 	// Create the session
 	sessionID := uuid.New().String()
-	session := &Session{
-		ID:        sessionID,
-		Shell:     opts.Shell,
-		Cwd:       opts.Cwd,
-		Cols:      opts.Cols,
-		Rows:      opts.Rows,
-		CreatedAt: time.Now(),
-		State:     StateRunning,
-		readers:   make([]io.Writer, 0),
-	}
-
-	// Prepare shell command with initialization script
-	shellPath, shellArgs, cleanup, err := prepareShellCommand(opts.Shell)
-	if err != nil {
-		return nil, fmt.Errorf("failed to prepare shell command: %w", err)
+	now := time.Now()
+	program := opts.Shell
+	if len(opts.Command) > 0 {
+		program = opts.Command[0]
 	}
-	if cleanup != nil {
-		// Store cleanup function to be called when session closes
-		session.initCleanup = cleanup
+	session := &Session{
+		ID:         sessionID,
+		Shell:      program,
+		ProfileID:  opts.ProfileID,
+		Cwd:        opts.Cwd,
+		Cols:       opts.Cols,
+		Rows:       opts.Rows,
+		CreatedAt:  now,
+		DetachedAt: now,
+		State:      StateRunning,
+		parser:     newBlockParser(nonce),
+		scrollback: newScrollback(opts.ScrollbackBytes),
+		outputDone: make(chan struct{}),
+		done:       make(chan struct{}),
+		limit:      limitState{activity: now},
+		share:      shareState{ownerToken: ownerToken, ownerSize: WindowSize{Cols: opts.Cols, Rows: opts.Rows}},
 	}
 
-	// Spawn PTY with shell
 	var cmd *exec.Cmd
-	if len(shellArgs) > 0 {
-		cmd = exec.Command(shellPath, shellArgs...)
+	if len(opts.Command) > 0 {
+		// Commands run as given; there is no shell to integrate with.
+		cmd = exec.Command(opts.Command[0], opts.Command[1:]...)
 	} else {
-		cmd = exec.Command(shellPath)
+		// Prepare shell command with initialization script
+		shellPath, shellArgs, cleanup, err := prepareShellCommand(opts.Shell, protocol, nonce, opts.Login, opts.Args, "")
+		if err != nil {
+			return nil, fmt.Errorf("failed to prepare shell command: %w", err)
+		}
+		if cleanup != nil {
+			// Store cleanup function to be called when session closes
+			session.initCleanup = cleanup
+		}
+		cmd = exec.Command(shellPath, shellArgs...)
 	}
 	cmd.Dir = opts.Cwd
-	cmd.Env = append(os.Environ(), opts.Env...)
-	cmd.Env = append(cmd.Env, getEnvSetup()...)
+	cmd.Env = append(os.Environ(), getEnvSetup(opts.Term)...)
+	cmd.Env = append(cmd.Env, opts.Env...)
+	session.env = cmd.Env
 
 	// Start the command with PTY
 	ptmx, err := pty.StartWithSize(cmd, &pty.Winsize{
@@ -82,6 +162,9 @@ func (m *Manager) StartSession(opts SessionOptions) (*Session, error) {
 		Cols: uint16(opts.Cols),
 	})
 	if err != nil {
+		if session.initCleanup != nil {
+			session.initCleanup()
+		}
 		return nil, fmt.Errorf("failed to start PTY: %w", err)
 	}
 
@@ -93,15 +176,50 @@ func (m *Manager) StartSession(opts SessionOptions) (*Session, error) {
 	m.sessions[sessionID] = session
 	m.mu.Unlock()
 
+	if opts.Record {
+		// Start before the reader so no output is missed.
+		if _, err := m.StartRecording(sessionID); err != nil {
+			log.Printf("session %s: failed to start recording: %v", sessionID, err)
+		}
+	}
+
 	// Start output reader goroutine
 	go m.readOutput(session)
 
 	// Monitor process exit
 	go m.monitorProcess(session, cmd)
 
+	// Track what owns the terminal (shell, vim, ssh, ...)
+	go m.watchForeground(session)
+
+	if len(opts.StartupCommands) > 0 {
+		// Typed at the first prompt (see readOutput); shells without the
+		// integration never report one, so they get them after a while.
+		session.startupInput = []byte(strings.Join(opts.StartupCommands, "\n") + "\n")
+		time.AfterFunc(startupCommandsTimeout, func() { m.sendStartupCommands(session) })
+	}
+
 	return session, nil
 }
 
+// startupCommandsTimeout is how long startup commands wait for the shell's
+// first prompt before they are typed anyway.
+const startupCommandsTimeout = 10 * time.Second
+
+// sendStartupCommands types a session's startup commands, once. Sent
+// before the shell has loaded its startup files, they could be read by
+// those files or echoed ahead of the prompt.
+func (m *Manager) sendStartupCommands(session *Session) {
+	session.startupOnce.Do(func() {
+		if len(session.startupInput) == 0 {
+			return
+		}
+		if err := m.WriteInput(session.ID, session.startupInput); err != nil {
+			log.Printf("session %s: failed to send startup commands: %v", session.ID, err)
+		}
+	})
+}
+
 // GetSession retrieves a session by ID.
 func (m *Manager) GetSession(sessionID string) (*Session, error) {
 	m.mu.RLock()
@@ -109,63 +227,25 @@ func (m *Manager) GetSession(sessionID string) (*Session, error) {
 
 	session, ok := m.sessions[sessionID]
 	if !ok {
-		return nil, fmt.Errorf("session not found: %s", sessionID)
+		return nil, fmt.Errorf("%w: %s", ErrSessionNotFound, sessionID)
 	}
 	return session, nil
 }
 
-// CloseSession closes a session and cleans up resources.
-func (m *Manager) CloseSession(sessionID string) error {
-	m.mu.Lock()
-	session, ok := m.sessions[sessionID]
-	if !ok {
-		m.mu.Unlock()
-		return fmt.Errorf("session not found: %s", sessionID)
-	}
-	delete(m.sessions, sessionID)
-	m.mu.Unlock()
-
-	session.mu.Lock()
-	defer session.mu.Unlock()
-
-	if session.State == StateClosed {
-		return nil // already closed
-	}
-
-	session.State = StateClosed
-
-	// Close PTY
-	if session.PTY != nil {
-		session.PTY.Close()
-	}
-
-	// Kill process if still running
-	if session.Process != nil {
-		if proc, ok := session.Process.(*os.Process); ok {
-			proc.Kill()
-		}
-	}
-
-	// Clean up init script file
-	if session.initCleanup != nil {
-		session.initCleanup()
-	}
-
-	return nil
-}
-
-// ResizeSession updates the terminal size for a session.
+// ResizeSession updates the terminal size the session's owner asked for.
+// Interactive viewers showing a smaller terminal keep it at their size; see
+// ResizeAs.
 func (m *Manager) ResizeSession(sessionID string, cols, rows int) error {
-	session, err := m.GetSession(sessionID)
-	if err != nil {
-		return err
-	}
+	return m.ResizeAs(sessionID, Access{}, cols, rows)
+}
 
+// setSize resizes a session's PTY and announces the new size to viewers.
+func (m *Manager) setSize(session *Session, cols, rows int) error {
+	var err error
 	session.mu.Lock()
-	defer session.mu.Unlock()
-
 	if session.State != StateRunning {
-		return fmt.Errorf("session is not running: %s", sessionID)
+		session.mu.Unlock()
+		return fmt.Errorf("session is not running: %s", session.ID)
 	}
 
 	session.Cols = cols
@@ -173,12 +253,21 @@ func (m *Manager) ResizeSession(sessionID string, cols, rows int) error {
 
 	// Resize PTY
 	if session.PTY != nil {
-		return pty.Setsize(session.PTY, &pty.Winsize{
+		err = pty.Setsize(session.PTY, &pty.Winsize{
 			Rows: uint16(rows),
 			Cols: uint16(cols),
 		})
 	}
+	session.mu.Unlock()
+
+	if err != nil {
+		return err
+	}
 
+	// Announce the new size in the output stream so every viewer sees it
+	// in order with the output that follows.
+	session.recordResize(time.Now(), cols, rows)
+	m.publish(session, []OutputChunk{{Resize: &WindowSize{Cols: cols, Rows: rows}}})
 	return nil
 }
 
@@ -190,46 +279,191 @@ func (m *Manager) WriteInput(sessionID string, data []byte) error {
 	}
 
 	session.mu.RLock()
-	defer session.mu.RUnlock()
-
 	if session.State != StateRunning {
+		session.mu.RUnlock()
 		return fmt.Errorf("session is not running: %s", sessionID)
 	}
 
 	if session.PTY == nil {
+		session.mu.RUnlock()
 		return fmt.Errorf("session PTY is nil: %s", sessionID)
 	}
 
 	_, err = session.PTY.Write(data)
+	session.mu.RUnlock()
+
+	if err == nil {
+		now := time.Now()
+		session.touch(now)
+		session.recordInput(now, data)
+	}
 	return err
 }
 
-// AddOutputWriter adds a writer to receive session output.
-func (m *Manager) AddOutputWriter(sessionID string, writer io.Writer) error {
+// Subscribe registers a new consumer of a session's output. The retained
+// scrollback from the given position onwards is returned for the caller to replay
+// before reading from the subscription; no chunk is lost or duplicated
+// between the two unless the subscriber falls behind, which policy then
+// handles. The caller must pass the subscription to Unsubscribe once it
+// stops reading.
+func (m *Manager) Subscribe(sessionID string, from Position, policy OverflowPolicy) (*Subscription, []OutputChunk, error) {
+	sub := newQueueSubscription(policy)
+	replay, err := m.subscribe(sessionID, from, sub)
+	if err != nil {
+		return nil, nil, err
+	}
+	return sub, replay, nil
+}
+
+// watch is Subscribe for the server's own use, such as WaitForOutput. The
+// subscription is not a client: it does not count in AttachedClients and
+// does not keep the session from being detached, and so reaped when idle.
+func (m *Manager) watch(sessionID string, from Position, policy OverflowPolicy) (*Subscription, []OutputChunk, error) {
+	sub := newQueueSubscription(policy)
+	sub.internal = true
+	replay, err := m.subscribe(sessionID, from, sub)
+	if err != nil {
+		return nil, nil, err
+	}
+	return sub, replay, nil
+}
+
+// newQueueSubscription returns a subscription that queues chunks on its
+// channel C.
+func newQueueSubscription(policy OverflowPolicy) *Subscription {
+	ch := make(chan OutputChunk, subscriberQueueLen)
+	return &Subscription{
+		C:      ch,
+		policy: policy,
+		send: func(chunk OutputChunk) bool {
+			select {
+			case ch <- chunk:
+				return true
+			default:
+				return false
+			}
+		},
+	}
+}
+
+// subscribe registers sub, whose policy and send are already set, with a
+// session and returns the scrollback to replay.
+func (m *Manager) subscribe(sessionID string, from Position, sub *Subscription) ([]OutputChunk, error) {
 	session, err := m.GetSession(sessionID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
+	sub.sessionID = sessionID
+	sub.done = make(chan struct{})
+
 	session.outputMu.Lock()
-	defer session.outputMu.Unlock()
+	session.subscribers = append(session.subscribers, sub)
+	replay := session.scrollback.Since(from)
+	exitErr := session.exitErr
+	session.outputMu.Unlock()
+
+	// The shell exited while we were looking the session up: replay what
+	// is left and end straight away.
+	if exitErr != nil {
+		sub.closeWith(exitErr)
+	}
+
+	if !sub.internal {
+		session.mu.Lock()
+		session.DetachedAt = time.Time{}
+		session.mu.Unlock()
+	}
+
+	return replay, nil
+}
+
+// Unsubscribe closes a subscription and removes it from the session.
+func (m *Manager) Unsubscribe(sessionID string, sub *Subscription) {
+	sub.Close()
+
+	session, err := m.GetSession(sessionID)
+	if err != nil {
+		return // session already gone; nothing left to detach from
+	}
+
+	session.outputMu.Lock()
+	session.removeSubscriber(sub)
+	detached := session.attachedClients() == 0
+	session.outputMu.Unlock()
+
+	if detached {
+		session.markDetached()
+	}
+}
+
+// removeSubscriber drops sub from the session's subscribers. The caller
+// must hold outputMu.
+func (s *Session) removeSubscriber(sub *Subscription) {
+	for i, other := range s.subscribers {
+		if other == sub {
+			s.subscribers = append(s.subscribers[:i], s.subscribers[i+1:]...)
+			return
+		}
+	}
+}
+
+// attachedClients returns how many subscribers are clients rather than the
+// server's own. The caller must hold outputMu.
+func (s *Session) attachedClients() int {
+	n := 0
+	for _, sub := range s.subscribers {
+		if !sub.internal {
+			n++
+		}
+	}
+	return n
+}
+
+// markDetached records when the session lost its last subscriber.
+func (s *Session) markDetached() {
+	s.mu.Lock()
+	if s.DetachedAt.IsZero() {
+		s.DetachedAt = time.Now()
+	}
+	s.mu.Unlock()
+}
+
+// DetachSession ends every output subscription of a session without
+// stopping the shell. Clients re-attach by subscribing again.
+func (m *Manager) DetachSession(sessionID string) error {
+	session, err := m.GetSession(sessionID)
+	if err != nil {
+		return err
+	}
+
+	session.outputMu.RLock()
+	subs := append([]*Subscription(nil), session.subscribers...)
+	session.outputMu.RUnlock()
 
-	session.readers = append(session.readers, writer)
+	for _, sub := range subs {
+		m.Unsubscribe(sessionID, sub)
+	}
 	return nil
 }
 
-// readOutput continuously reads from PTY and broadcasts to subscribers.
+// readOutput continuously reads from PTY, strips block markers and
+// broadcasts the resulting chunks to subscribers.
 func (m *Manager) readOutput(session *Session) {
 	buf := make([]byte, 4096) // 4KB chunks
 
+	defer close(session.outputDone)
+
+	// Read until the PTY is closed or, once the shell and everything else
+	// holding the terminal has exited, reports EIO. Stopping as soon as the
+	// shell exits would lose its last output.
 	for {
 		session.mu.RLock()
-		if session.State != StateRunning || session.PTY == nil {
-			session.mu.RUnlock()
-			break
-		}
 		pty := session.PTY
 		session.mu.RUnlock()
+		if pty == nil {
+			break
+		}
 
 		n, err := pty.Read(buf)
 		if err != nil {
@@ -240,57 +474,192 @@ func (m *Manager) readOutput(session *Session) {
 		}
 
 		if n > 0 {
-			data := make([]byte, n)
-			copy(data, buf[:n])
-
-			// Broadcast raw output to all subscribers (including markers)
-			session.outputMu.RLock()
-			for _, writer := range session.readers {
-				_, _ = writer.Write(data)
+			dirs := session.osc7.Scan(buf[:n])
+			for _, dir := range dirs {
+				session.setCwd(dir)
+			}
+			if len(dirs) > 0 {
+				// The integration reports the cwd as it draws each prompt,
+				// so the shell is ready for input.
+				go m.sendStartupCommands(session)
+			}
+			m.publish(session, session.parser.Feed(buf[:n]))
+			if alt, ok := session.altScan.Scan(buf[:n]); ok {
+				m.updateState(session, &alt)
 			}
-			session.outputMu.RUnlock()
 		}
 	}
 
+	// Release anything the parser was holding back for a split marker.
+	m.publish(session, session.parser.Flush())
+
+	// No more output can arrive; finish any recording.
+	session.stopRecording()
+
 	log.Printf("session %s: output reader stopped", session.ID)
 }
 
-// monitorProcess watches the shell process and updates session state on exit.
+// publish records block transitions on the session, appends chunks to the
+// scrollback and delivers them to every subscriber in order.
+func (m *Manager) publish(session *Session, chunks []OutputChunk) {
+	for i := range chunks {
+		chunk := &chunks[i]
+		chunk.SessionID = session.ID
+		chunk.Timestamp = time.Now()
+
+		if chunk.Status != "" && len(chunk.Data) == 0 {
+			session.applyFailBlock(chunk)
+			m.trackCommand(session, chunk)
+		}
+
+		// Hold the write lock across append and delivery so a concurrent
+		// Subscribe sees each chunk either in its replay or on its channel.
+		// Delivery only queues, so a stalled subscriber cannot hold up the
+		// PTY read loop or anyone else.
+		session.outputMu.Lock()
+		*chunk = session.scrollback.Append(*chunk)
+		var overflowed []*Subscription
+		for _, sub := range session.subscribers {
+			if !sub.deliver(*chunk) {
+				overflowed = append(overflowed, sub)
+			}
+		}
+		for _, sub := range overflowed {
+			log.Printf("session %s: disconnecting output subscriber that fell behind", session.ID)
+			session.removeSubscriber(sub)
+		}
+		detached := len(overflowed) > 0 && session.attachedClients() == 0
+		session.outputMu.Unlock()
+
+		if detached {
+			session.markDetached()
+		}
+
+		if len(chunk.Data) > 0 {
+			session.touch(chunk.Timestamp)
+			session.recordOutput(chunk.Timestamp, chunk.Data)
+			if hits := m.matchTriggers(session, *chunk); len(hits) > 0 {
+				m.fireTriggers(session, hits)
+			}
+		}
+	}
+}
+
+// trackCommand records a block transition on the session and mirrors it to
+// the command recorder: a history row is written when a command starts and
+// completed with its exit code and duration when it ends.
+func (m *Manager) trackCommand(session *Session, chunk *OutputChunk) {
+	m.mu.RLock()
+	recorder := m.recorder
+	m.mu.RUnlock()
+
+	session.mu.Lock()
+	defer session.mu.Unlock()
+
+	session.CurrentCommandID = chunk.CommandID
+	session.CommandStatus = chunk.Status
+	session.CommandExitCode = chunk.ExitCode
+
+	if chunk.Status == CommandRunning {
+		session.CurrentCommand = chunk.Command
+		session.commandStartedAt = chunk.Timestamp
+		session.historyID = nil
+		if recorder != nil && chunk.Command != "" {
+			session.historyID = recorder.RecordCommandStart(
+				session.ID, filepath.Base(session.Shell), session.Cwd, chunk.Command)
+		}
+		return
+	}
+
+	idCh := session.historyID
+	session.historyID = nil
+	if idCh == nil {
+		return
+	}
+	exitCode := chunk.ExitCode
+	duration := chunk.Timestamp.Sub(session.commandStartedAt)
+	go func() {
+		// The insert is asynchronous; wait for its ID off the output path.
+		id, ok := <-idCh
+		if !ok {
+			return
+		}
+		if err := recorder.UpdateExitCode(id, exitCode, duration); err != nil {
+			log.Printf("session %s: failed to update command exit code: %v", session.ID, err)
+		}
+	}()
+}
+
+// monitorProcess waits for the shell to exit, lets the output reader pass on
+// what is left, then ends the session's streams with the exit status.
 func (m *Manager) monitorProcess(session *Session, cmd *exec.Cmd) {
-	err := cmd.Wait()
+	cmd.Wait()
+	exit := exitStatusOf(cmd.ProcessState)
 
 	session.mu.Lock()
+	session.limitMu.Lock()
+	exit.Reaped = session.limit.reapedReason
+	session.limitMu.Unlock()
+	exit.ClosedByUser = session.State == StateClosed && exit.Reaped == ""
 	if session.State == StateRunning {
 		session.State = StateExited
 	}
+	session.exit = exit
 	session.mu.Unlock()
 
-	if err != nil {
-		log.Printf("session %s: process exited with error: %v", session.ID, err)
-	} else {
-		log.Printf("session %s: process exited normally", session.ID)
+	select {
+	case <-session.outputDone:
+	case <-time.After(outputDrainTimeout):
+		log.Printf("session %s: terminal still open after shell exit, closing it", session.ID)
 	}
 
+	// CloseSession has already released a session closed by the user.
+	session.mu.Lock()
+	if session.State == StateExited {
+		if session.PTY != nil {
+			session.PTY.Close()
+		}
+		if session.initCleanup != nil {
+			session.initCleanup()
+		}
+	}
+	session.mu.Unlock()
+
 	// Cleanup from manager
 	m.mu.Lock()
-	delete(m.sessions, session.ID)
+	removed := m.sessions[session.ID] == session
+	if removed {
+		delete(m.sessions, session.ID)
+	}
 	m.mu.Unlock()
+	if removed {
+		m.removeFromGroups(session.ID)
+	}
+
+	session.finish(exit)
 }
 
-// ListSessions returns all active sessions.
+// ListSessions returns all active sessions, oldest first.
 func (m *Manager) ListSessions() []*Session {
 	m.mu.RLock()
-	defer m.mu.RUnlock()
-
 	sessions := make([]*Session, 0, len(m.sessions))
 	for _, s := range m.sessions {
 		sessions = append(sessions, s)
 	}
+	m.mu.RUnlock()
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].CreatedAt.Before(sessions[j].CreatedAt)
+	})
 	return sessions
 }
 
-// Close closes all sessions and cleans up resources.
+// Close stops enforcing limits and closes all sessions, concurrently so
+// that their grace periods overlap. It returns once every one has been
+// terminated.
 func (m *Manager) Close() error {
+	m.stopOnce.Do(func() { close(m.stop) })
+
 	m.mu.RLock()
 	sessionIDs := make([]string, 0, len(m.sessions))
 	for id := range m.sessions {
@@ -298,11 +667,17 @@ func (m *Manager) Close() error {
 	}
 	m.mu.RUnlock()
 
+	var wg sync.WaitGroup
 	for _, id := range sessionIDs {
-		if err := m.CloseSession(id); err != nil {
-			log.Printf("error closing session %s: %v", id, err)
-		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := m.CloseSession(id, 0); err != nil {
+				log.Printf("error closing session %s: %v", id, err)
+			}
+		}()
 	}
+	wg.Wait()
 
 	return nil
 }
@@ -1,16 +1,20 @@
 package session
 
 import (
-	"io"
+	"errors"
+	"fmt"
 	"os"
 	"sync"
 	"time"
+
+	"github.com/entl/blockterm/internal/recording"
 )
 
 // Session represents a single PTY session (shell process).
 type Session struct {
 	ID        string
 	Shell     string
+	ProfileID string // profile the session was started from, if any
 	Cwd       string
 	Cols      int
 	Rows      int
@@ -19,14 +23,56 @@ type Session struct {
 	CreatedAt time.Time
 	State     SessionState
 
+	// DetachedAt is when the last output subscriber went away (or when the
+	// session was created if nobody has attached yet). Zero while attached.
+	DetachedAt time.Time
+
 	// For output streaming
-	outputMu sync.RWMutex
-	readers  []io.Writer // Subscribers for output
+	outputMu    sync.RWMutex
+	subscribers []*Subscription // Subscribers for output
+	parser      *blockParser    // Strips block markers from PTY output
+	scrollback  *scrollback     // Recent output replayed to new subscribers
+	osc7        osc7Scanner     // Tracks cwd reported by the shell via OSC 7
+	altScan     altScreenScanner
+
+	cwdHistory []CwdChange // Directories visited, oldest first
+
+	// What currently owns the terminal
+	foreground ProcessInfo // Leader of the foreground process group
+	altScreen  bool        // Alternate screen buffer active
 
 	// Current command tracking
 	CurrentCommandID string
+	CurrentCommand   string // Command line reported by the shell, if any
 	CommandStatus    string // "running", "completed", "failed"
 	CommandExitCode  int
+	commandStartedAt time.Time
+	historyID        <-chan int64 // Pending history row for the running command
+
+	recording *recording.Writer // Active asciicast recording, if any
+	env       []string          // Environment the program was started with
+
+	// Termination
+	outputDone chan struct{} // closed when the output reader stops
+	done       chan struct{} // closed when the shell has exited; see Done
+	exit       ExitStatus
+	exitErr    *ExitError // set once finished; guarded by outputMu
+
+	// Idle and lifetime limits; see Limits
+	limitMu sync.Mutex
+	limit   limitState
+
+	// Output triggers; see SetTriggers
+	triggerMu sync.Mutex
+	triggers  triggerState
+
+	// Clients the session is shared with; see ShareSession
+	shareMu sync.Mutex
+	share   shareState
+
+	// Startup commands waiting for the first prompt; see StartupCommands
+	startupInput []byte
+	startupOnce  sync.Once
 
 	// Cleanup function for init script
 	initCleanup func()
@@ -46,10 +92,24 @@ const (
 // SessionOptions contains options for creating a new session.
 type SessionOptions struct {
 	Shell string   // Optional: override default shell
+	Args  []string // Optional: extra shell arguments, after the integration's own
+	Login bool     // Optional: start the shell as a login shell
 	Cwd   string   // Optional: starting directory
 	Cols  int      // Terminal columns
 	Rows  int      // Terminal rows
 	Env   []string // Optional: additional environment variables
+	Term  string   // Optional: TERM (default xterm-256color)
+
+	// Command, if set, is run directly under the PTY instead of a shell,
+	// without shell integration. Shell, Args and Login must be unset.
+	Command []string
+
+	StartupCommands []string // Optional: typed into the shell at its first prompt
+	ProfileID       string   // Optional: profile the options came from, for triggers scoped to it
+
+	ScrollbackBytes int            // Optional: scrollback retained for replay (default 1 MiB)
+	MarkerProtocol  MarkerProtocol // Optional: shell-integration markers (default MarkerProtocolBlockTerm)
+	Record          bool           // Optional: record the session as asciicast (needs a recording store)
 }
 
 // OutputChunk represents a chunk of PTY output.
@@ -57,7 +117,188 @@ type OutputChunk struct {
 	SessionID string
 	Data      []byte
 	CommandID string // Unique identifier for the current command block
+	Command   string // Command line (only set on the chunk that starts a block)
 	Status    string // "running", "completed", "failed"
 	ExitCode  int    // Exit code (only set when status is completed/failed)
+	FailedBy  string // fail_block trigger that failed a block whose command succeeded
+	Offset    int64  // Byte offset of Data within the session's output stream
+	Seq       uint64 // Position of the chunk among the session's chunks, from 1
 	Timestamp time.Time
+
+	State   *StateChange  // Set on data-less chunks reporting a foreground/alt-screen change
+	Resize  *WindowSize   // Set on data-less chunks reporting a resize; later output uses the new size
+	Exit    *ExitStatus   // Set on the final chunk of a stream whose session ended
+	Event   *SessionEvent // Set on data-less chunks warning that a limit will close the session
+	Trigger *TriggerHit   // Set on data-less chunks reporting that a trigger matched earlier output
+
+	// Dropped counts chunks discarded just before this one because the
+	// subscriber fell behind; the gap in Offset shows the bytes lost.
+	Dropped int
+}
+
+// WindowSize is a terminal size in character cells.
+type WindowSize struct {
+	Cols int
+	Rows int
+}
+
+// SessionInfo is a point-in-time snapshot of a session's public state.
+type SessionInfo struct {
+	ID               string
+	Shell            string
+	Cwd              string
+	Cols             int
+	Rows             int
+	State            SessionState
+	CreatedAt        time.Time
+	CurrentCommandID string
+	CurrentCommand   string
+	CommandStatus    string
+	CommandExitCode  int
+	AttachedClients  int
+	DetachedAt       time.Time
+	Foreground       ProcessInfo
+	AltScreen        bool
+	RecordingID      string
+	OutputOffset     int64 // end of the output stream so far
+	Shares           []ShareInfo
+}
+
+// Info returns a consistent snapshot of the session's state.
+func (s *Session) Info() SessionInfo {
+	s.mu.RLock()
+	info := SessionInfo{
+		ID:               s.ID,
+		Shell:            s.Shell,
+		Cwd:              s.Cwd,
+		Cols:             s.Cols,
+		Rows:             s.Rows,
+		State:            s.State,
+		CreatedAt:        s.CreatedAt,
+		CurrentCommandID: s.CurrentCommandID,
+		CurrentCommand:   s.CurrentCommand,
+		CommandStatus:    s.CommandStatus,
+		CommandExitCode:  s.CommandExitCode,
+		DetachedAt:       s.DetachedAt,
+		Foreground:       s.foreground,
+		AltScreen:        s.altScreen,
+	}
+	if s.recording != nil {
+		info.RecordingID = s.recording.ID()
+	}
+	s.mu.RUnlock()
+
+	s.outputMu.RLock()
+	info.AttachedClients = s.attachedClients()
+	info.OutputOffset = s.scrollback.end
+	s.outputMu.RUnlock()
+
+	info.Shares = s.shareInfo()
+
+	return info
+}
+
+// subscriberQueueLen bounds how many chunks may wait for one subscriber.
+const subscriberQueueLen = 1024
+
+// ErrSlowConsumer is reported by a subscription closed under
+// OverflowDisconnect because its queue filled up.
+var ErrSlowConsumer = errors.New("output subscriber fell too far behind")
+
+// OverflowPolicy decides what happens when a subscriber's queue is full.
+// Output is never held back for a slow subscriber: the PTY keeps being
+// read and every other subscriber keeps receiving.
+type OverflowPolicy string
+
+const (
+	// OverflowDropGap discards chunks that do not fit and marks the next
+	// delivered chunk with how many were dropped. Clients can refill the gap
+	// from the scrollback by re-subscribing from the last offset they saw.
+	OverflowDropGap OverflowPolicy = "drop"
+	// OverflowDisconnect closes the subscription with ErrSlowConsumer.
+	OverflowDisconnect OverflowPolicy = "disconnect"
+)
+
+// ParseOverflowPolicy parses a policy name; "" selects OverflowDropGap.
+func ParseOverflowPolicy(name string) (OverflowPolicy, error) {
+	switch OverflowPolicy(name) {
+	case "", OverflowDropGap:
+		return OverflowDropGap, nil
+	case OverflowDisconnect:
+		return OverflowDisconnect, nil
+	default:
+		return "", fmt.Errorf("unknown overflow policy %q", name)
+	}
+}
+
+// Subscription delivers a session's parsed output chunks to one consumer
+// through a bounded queue. Delivery never blocks; see OverflowPolicy.
+type Subscription struct {
+	C <-chan OutputChunk // nil for subscriptions belonging to a Mux
+
+	sessionID string
+	send      func(OutputChunk) bool // queues a chunk, or reports false if the queue is full
+	policy    OverflowPolicy
+	dropped   int // chunks dropped since the last delivery; guarded by the session's outputMu
+	done      chan struct{}
+	closeOnce sync.Once
+	err       error
+	onClose   func(*Subscription) // called once when the subscription ends; must not block
+	internal  bool                // the server's own, not a client's; see watch
+}
+
+// deliver queues chunk without blocking. It reports false if the
+// subscription must be removed because it overflowed under
+// OverflowDisconnect. The caller must hold the session's outputMu.
+func (s *Subscription) deliver(chunk OutputChunk) bool {
+	select {
+	case <-s.done:
+		return true // closed; Unsubscribe removes it
+	default:
+	}
+
+	chunk.Dropped = s.dropped
+	if s.send(chunk) {
+		s.dropped = 0
+		return true
+	}
+
+	if s.policy == OverflowDisconnect {
+		s.closeWith(ErrSlowConsumer)
+		return false
+	}
+	s.dropped++
+	return true
+}
+
+// Close stops delivery to the subscription. It is safe to call more than once.
+func (s *Subscription) Close() {
+	s.closeWith(nil)
+}
+
+func (s *Subscription) closeWith(err error) {
+	s.closeOnce.Do(func() {
+		s.err = err
+		close(s.done)
+		if s.onClose != nil {
+			s.onClose(s)
+		}
+	})
+}
+
+// Err reports why the subscription ended once Done is closed: ErrSlowConsumer
+// if it overflowed, nil otherwise.
+func (s *Subscription) Err() error {
+	select {
+	case <-s.done:
+		return s.err
+	default:
+		return nil
+	}
+}
+
+// Done is closed once the subscription stops receiving output, either
+// because the consumer closed it or because the session was detached.
+func (s *Subscription) Done() <-chan struct{} {
+	return s.done
 }
@@ -0,0 +1,374 @@
+package session
+
+import (
+	"errors"
+	"io"
+	"log"
+	"sync"
+
+	pb "github.com/entl/blockterm/gen/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Reasons reported when a session leaves a Mux without being removed.
+const (
+	EndedNotFound         = "not_found"
+	EndedInvalid          = "invalid"
+	EndedPermissionDenied = "permission_denied"
+	EndedRevoked          = "revoked"
+	EndedExited           = "exited"
+	EndedDetached         = "detached"
+	EndedOverflow         = "overflow"
+)
+
+// MuxChunk is a chunk queued by a Mux, with the subscription it came from.
+type MuxChunk struct {
+	OutputChunk
+	sub *Subscription
+}
+
+// MuxEnded reports a session that stopped streaming on its own.
+type MuxEnded struct {
+	SessionID string
+	Reason    string
+	Exit      *ExitError // set if the session's shell ended
+
+	sub *Subscription
+}
+
+// Mux streams the output of several sessions through one bounded queue, so
+// a client watching many panes needs a single reader. Each session keeps its
+// own subscription, overflow policy and gap accounting.
+type Mux struct {
+	C <-chan MuxChunk
+
+	m  *Manager
+	ch chan MuxChunk
+
+	mu     sync.Mutex
+	subs   map[string]*Subscription
+	ended  []MuxEnded
+	notify chan struct{} // signalled when ended grows
+}
+
+// NewMux creates an empty Mux. The caller must Close it once done.
+func (m *Manager) NewMux() *Mux {
+	ch := make(chan MuxChunk, subscriberQueueLen)
+	return &Mux{
+		C:      ch,
+		m:      m,
+		ch:     ch,
+		subs:   make(map[string]*Subscription),
+		notify: make(chan struct{}, 1),
+	}
+}
+
+// Add starts streaming a session, replacing any existing subscription to
+// it, and returns the scrollback from the given position to send first.
+func (x *Mux) Add(sessionID string, from Position, policy OverflowPolicy) ([]OutputChunk, error) {
+	x.Remove(sessionID)
+
+	sub := &Subscription{policy: policy, onClose: x.subscriptionClosed}
+	sub.send = func(chunk OutputChunk) bool {
+		select {
+		case x.ch <- MuxChunk{OutputChunk: chunk, sub: sub}:
+			return true
+		default:
+			return false
+		}
+	}
+
+	// Register first so an immediate end is reported.
+	x.mu.Lock()
+	x.subs[sessionID] = sub
+	x.mu.Unlock()
+
+	replay, err := x.m.subscribe(sessionID, from, sub)
+	if err != nil {
+		x.mu.Lock()
+		delete(x.subs, sessionID)
+		x.mu.Unlock()
+		return nil, err
+	}
+	return replay, nil
+}
+
+// Remove stops streaming a session. Chunks of it that are still queued stop
+// being Current.
+func (x *Mux) Remove(sessionID string) {
+	x.mu.Lock()
+	sub, ok := x.subs[sessionID]
+	delete(x.subs, sessionID)
+	x.mu.Unlock()
+
+	if ok {
+		x.m.Unsubscribe(sessionID, sub)
+	}
+}
+
+// Current reports whether a queued chunk belongs to a session's
+// subscription rather than one the client removed or replaced since.
+func (x *Mux) Current(chunk MuxChunk) bool {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	return x.subs[chunk.SessionID] == chunk.sub
+}
+
+// Sessions returns the IDs of the sessions being streamed.
+func (x *Mux) Sessions() []string {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	ids := make([]string, 0, len(x.subs))
+	for id := range x.subs {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// Ended is signalled when sessions have ended on their own; collect them
+// with TakeEnded.
+func (x *Mux) Ended() <-chan struct{} { return x.notify }
+
+// TakeEnded returns the sessions that ended since the last call and drops
+// them from the Mux. Chunks they queued before ending are Current until
+// then, so read everything queued first.
+func (x *Mux) TakeEnded() []MuxEnded {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	var ended []MuxEnded
+	for _, e := range x.ended {
+		if x.subs[e.SessionID] != e.sub {
+			continue // removed or replaced by the client meanwhile
+		}
+		delete(x.subs, e.SessionID)
+		ended = append(ended, e)
+	}
+	x.ended = nil
+	return ended
+}
+
+// Close removes every session.
+func (x *Mux) Close() {
+	for _, id := range x.Sessions() {
+		x.Remove(id)
+	}
+}
+
+// subscriptionClosed runs when a member subscription ends. Subscriptions
+// the client removed are already gone from subs and are not reported. It
+// may be called with the session's outputMu held.
+func (x *Mux) subscriptionClosed(sub *Subscription) {
+	ended := MuxEnded{SessionID: sub.sessionID, Reason: EndedDetached, sub: sub}
+	switch err := sub.Err(); {
+	case errors.As(err, &ended.Exit):
+		ended.Reason = EndedExited
+	case errors.Is(err, ErrSlowConsumer):
+		ended.Reason = EndedOverflow
+	}
+
+	x.mu.Lock()
+	if x.subs[sub.sessionID] != sub {
+		x.mu.Unlock()
+		return
+	}
+	x.ended = append(x.ended, ended)
+	x.mu.Unlock()
+
+	select {
+	case x.notify <- struct{}{}:
+	default:
+	}
+}
+
+// muxStream is a session added to SubscribeAll with the token it was
+// authorized with.
+type muxStream struct {
+	sessionID string
+	release   func()        // from OpenStream
+	stop      chan struct{} // closed to stop watching for revocation
+}
+
+// SubscribeAll streams the output of every session the client adds, tagged
+// by session ID, on one stream. Each session is added with its own share
+// token. Sessions that cannot be added or that stop streaming, including
+// when their share is revoked, are reported with a SubscriptionEnded frame;
+// the stream itself only ends when the client goes away.
+func (s *Service) SubscribeAll(stream pb.TerminalService_SubscribeAllServer) error {
+	mux := s.manager.NewMux()
+	defer mux.Close()
+
+	streams := make(map[string]*muxStream)
+	revoked := make(chan *muxStream)
+	drop := func(sessionID string) {
+		if ms, ok := streams[sessionID]; ok {
+			close(ms.stop)
+			ms.release()
+			delete(streams, sessionID)
+		}
+	}
+	defer func() {
+		for id := range streams {
+			drop(id)
+		}
+	}()
+
+	requests := make(chan *pb.SubscribeAllRequest)
+	recvDone := make(chan error, 1)
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				recvDone <- err
+				return
+			}
+			select {
+			case requests <- req:
+			case <-stream.Context().Done():
+				return
+			}
+		}
+	}()
+
+	send := func(resp *pb.SubscribeAllResponse) error {
+		if err := stream.Send(resp); err != nil {
+			log.Printf("error streaming multiplexed output: %v", err)
+			return status.Errorf(codes.Internal, "error streaming output: %v", err)
+		}
+		return nil
+	}
+	sendEnded := func(sessionID, reason, message string) error {
+		return send(&pb.SubscribeAllResponse{Frame: &pb.SubscribeAllResponse_Ended{Ended: &pb.SubscriptionEnded{
+			SessionId: sessionID,
+			Reason:    reason,
+			Message:   message,
+		}}})
+	}
+	sendChunk := func(chunk OutputChunk) error {
+		return send(&pb.SubscribeAllResponse{Frame: &pb.SubscribeAllResponse_Output{Output: toProtoChunk(chunk)}})
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+
+		case err := <-recvDone:
+			if err != io.EOF {
+				return err
+			}
+			// The client cannot change the set any more but still reads.
+			recvDone = nil
+
+		case req := <-requests:
+			for _, id := range req.Remove {
+				mux.Remove(id)
+				drop(id)
+			}
+			for _, add := range req.Add {
+				policy, err := ParseOverflowPolicy(add.OverflowPolicy)
+				if err != nil {
+					if err := sendEnded(add.SessionId, EndedInvalid, err.Error()); err != nil {
+						return err
+					}
+					continue
+				}
+
+				// Adding a session again replaces its stream, token included.
+				drop(add.SessionId)
+				access, err := s.manager.Authorize(add.SessionId, add.ShareToken)
+				if err != nil {
+					mux.Remove(add.SessionId)
+					reason := EndedNotFound
+					if errors.Is(err, ErrInvalidShareToken) {
+						reason = EndedPermissionDenied
+					}
+					if err := sendEnded(add.SessionId, reason, err.Error()); err != nil {
+						return err
+					}
+					continue
+				}
+				replay, err := mux.Add(add.SessionId, fromPosition(add.FromOffset, add.AfterSeq), policy)
+				if err != nil {
+					if err := sendEnded(add.SessionId, EndedNotFound, err.Error()); err != nil {
+						return err
+					}
+					continue
+				}
+
+				ms := &muxStream{
+					sessionID: add.SessionId,
+					release:   s.manager.OpenStream(add.SessionId, access),
+					stop:      make(chan struct{}),
+				}
+				streams[add.SessionId] = ms
+				if r := access.Revoked(); r != nil {
+					go func() {
+						select {
+						case <-r:
+							select {
+							case revoked <- ms:
+							case <-ms.stop:
+							}
+						case <-ms.stop:
+						}
+					}()
+				}
+
+				for _, chunk := range replay {
+					if err := sendChunk(chunk); err != nil {
+						return err
+					}
+				}
+			}
+
+		case ms := <-revoked:
+			if streams[ms.sessionID] != ms {
+				continue // removed or replaced by the client meanwhile
+			}
+			mux.Remove(ms.sessionID)
+			drop(ms.sessionID)
+			log.Printf("session %s: share revoked, ending multiplexed output", ms.sessionID)
+			if err := sendEnded(ms.sessionID, EndedRevoked, "share revoked"); err != nil {
+				return err
+			}
+
+		case chunk := <-mux.C:
+			if !mux.Current(chunk) {
+				continue
+			}
+			if err := sendChunk(chunk.OutputChunk); err != nil {
+				return err
+			}
+
+		case <-mux.Ended():
+			// Everything the ended sessions queued goes out before they
+			// are reported.
+			for len(mux.C) > 0 {
+				if chunk := <-mux.C; mux.Current(chunk) {
+					if err := sendChunk(chunk.OutputChunk); err != nil {
+						return err
+					}
+				}
+			}
+			for _, ended := range mux.TakeEnded() {
+				drop(ended.SessionID)
+				log.Printf("session %s: multiplexed output ended: %s", ended.SessionID, ended.Reason)
+				message := ""
+				if ended.Exit != nil {
+					if err := sendChunk(exitChunk(ended.SessionID, ended.Exit)); err != nil {
+						return err
+					}
+					message = ended.Exit.Error()
+				}
+				if err := sendEnded(ended.SessionID, ended.Reason, message); err != nil {
+					return err
+				}
+			}
+
+		}
+	}
+}
@@ -0,0 +1,357 @@
+package session
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"encoding/base64"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Plain-text framing shared by every BlockTerm marker, e.g.
+// "<<<BLOCKTERM:START nonce=<n> cmd=bHMgLWw=>>>" or
+// "<<<BLOCKTERM:END nonce=<n> exit=0>>>".
+const (
+	markerPrefix = "<<<BLOCKTERM:"
+	markerSuffix = ">>>"
+
+	// maxMarkerLen bounds how long the parser will hold back an unterminated
+	// marker before giving up and passing the bytes through as output.
+	maxMarkerLen = 4096
+)
+
+// Semantic prompt sequences, the escape-sequence alternative to the
+// plain-text markers: FinalTerm's OSC 133 (A prompt, B input, C output,
+// D;<exit> finished) and VS Code's OSC 633, which uses the same letters and
+// adds E;<command line>;<nonce> ahead of C. Both are terminated by BEL or
+// ST. C and D carry the nonce as a "nonce=<n>" parameter.
+const (
+	osc133Prefix = "\x1b]133;"
+	osc633Prefix = "\x1b]633;"
+)
+
+// markerPrefixes lists every framing the parser recognizes.
+var markerPrefixes = []string{markerPrefix, osc133Prefix, osc633Prefix}
+
+// Command status values reported on OutputChunk.Status.
+const (
+	CommandRunning   = "running"
+	CommandCompleted = "completed"
+	CommandFailed    = "failed"
+)
+
+// blockParser is a streaming parser for the shell-integration markers.
+// It strips START/END markers (or their OSC 133/633 equivalents) from the
+// PTY stream, assigns a command ID to each block and tags every emitted
+// chunk with the block it belongs to. Markers split across reads are held
+// back until they can be decided. Unknown markers (e.g. PYENV, OSC 633;P)
+// and markers that do not carry the session nonce are passed through
+// untouched.
+type blockParser struct {
+	pending     []byte // tail of the previous read that may start a marker
+	commandID   string // ID of the block currently receiving output
+	commandLine string // command line reported by OSC 633;E, awaiting C
+	nonce       string // token markers must carry; "" accepts any marker
+	newID       func() string
+}
+
+// newBlockParser creates a parser that assigns UUID command IDs and only
+// honors markers carrying nonce.
+func newBlockParser(nonce string) *blockParser {
+	return &blockParser{
+		nonce: nonce,
+		newID: func() string { return uuid.New().String() },
+	}
+}
+
+// Feed consumes the next slice of raw PTY output and returns the chunks it
+// produced. SessionID and Timestamp are left for the caller to fill in.
+func (p *blockParser) Feed(data []byte) []OutputChunk {
+	buf := append(p.pending, data...)
+	p.pending = nil
+
+	var chunks []OutputChunk
+	var out []byte // passthrough bytes not yet emitted
+
+	flush := func() {
+		if len(out) > 0 {
+			chunks = append(chunks, p.dataChunk(out))
+			out = nil
+		}
+	}
+
+	for len(buf) > 0 {
+		idx, prefix := nextMarker(buf)
+		if idx == -1 {
+			// Hold back a trailing partial prefix; emit everything else.
+			keep := 0
+			for _, prefix := range markerPrefixes {
+				keep = max(keep, partialSuffixLen(buf, prefix))
+			}
+			out = append(out, buf[:len(buf)-keep]...)
+			p.pending = append(p.pending, buf[len(buf)-keep:]...)
+			break
+		}
+
+		out = append(out, buf[:idx]...)
+		rest := buf[idx:]
+		body := rest[len(prefix):]
+
+		var end, termLen int
+		if prefix == markerPrefix {
+			end, termLen = bytes.Index(body, []byte(markerSuffix)), len(markerSuffix)
+		} else {
+			end, termLen = oscTerminator(body)
+		}
+		if end == -1 {
+			if len(rest) > maxMarkerLen {
+				// Never terminated – not a marker after all.
+				out = append(out, rest[:len(prefix)]...)
+				buf = body
+				continue
+			}
+			p.pending = append(p.pending, rest...)
+			break
+		}
+
+		markerLen := len(prefix) + end + termLen
+		payload := string(body[:end])
+
+		// Output ahead of the marker belongs to the block it was printed
+		// in, before the marker opens or closes one.
+		flush()
+
+		var chunk *OutputChunk
+		var consumed bool
+		if prefix == markerPrefix {
+			chunk, consumed = p.handleMarker(payload)
+		} else {
+			chunk, consumed = p.handleSemanticPrompt(payload)
+		}
+		if !consumed {
+			out = append(out, rest[:markerLen]...)
+		} else if chunk != nil {
+			chunks = append(chunks, *chunk)
+		}
+		buf = rest[markerLen:]
+	}
+
+	flush()
+	return chunks
+}
+
+// Flush returns any held-back bytes as a final output chunk. It is called
+// when the PTY reaches EOF and no further data can complete a marker.
+func (p *blockParser) Flush() []OutputChunk {
+	if len(p.pending) == 0 {
+		return nil
+	}
+	chunk := p.dataChunk(p.pending)
+	p.pending = nil
+	return []OutputChunk{chunk}
+}
+
+// handleMarker interprets a marker payload. consumed=false means the parser
+// does not own this marker and it is passed through as output; a consumed
+// marker may still yield no chunk when it carries no block transition.
+func (p *blockParser) handleMarker(payload string) (chunk *OutputChunk, consumed bool) {
+	verb, fields := parseMarkerFields(payload)
+	switch verb {
+	case "START":
+		if !p.verify(fields["nonce"]) {
+			return nil, false
+		}
+		return p.startBlock(decodeStartCommand(fields["cmd"])), true
+
+	case "END":
+		exitCode, err := strconv.Atoi(fields["exit"])
+		if err != nil || !p.verify(fields["nonce"]) {
+			return nil, false
+		}
+		return p.endBlock(exitCode), true
+
+	default:
+		return nil, false
+	}
+}
+
+// parseMarkerFields splits a marker payload such as "END nonce=ab exit=0"
+// into its verb and key=value fields.
+func parseMarkerFields(payload string) (string, map[string]string) {
+	parts := strings.Fields(payload)
+	if len(parts) == 0 {
+		return "", nil
+	}
+	fields := make(map[string]string, len(parts)-1)
+	for _, part := range parts[1:] {
+		if key, value, ok := strings.Cut(part, "="); ok {
+			fields[key] = value
+		}
+	}
+	return parts[0], fields
+}
+
+// verify reports whether token matches the session nonce.
+func (p *blockParser) verify(token string) bool {
+	return p.nonce == "" || subtle.ConstantTimeCompare([]byte(token), []byte(p.nonce)) == 1
+}
+
+// handleSemanticPrompt interprets the payload of an OSC 133 or OSC 633
+// sequence (the part after "133;" / "633;") like handleMarker does.
+func (p *blockParser) handleSemanticPrompt(payload string) (chunk *OutputChunk, consumed bool) {
+	kind, args, _ := strings.Cut(payload, ";")
+	switch kind {
+	case "A", "B":
+		// Prompt and input boundaries carry no block transition.
+		return nil, true
+
+	case "E":
+		cmd, nonce, _ := strings.Cut(args, ";")
+		nonce, _, _ = strings.Cut(nonce, ";")
+		if !p.verify(nonce) {
+			return nil, false
+		}
+		p.commandLine = decodeOSC633(cmd)
+		return nil, true
+
+	case "C":
+		if !p.verify(oscParam(args, "nonce")) {
+			return nil, false
+		}
+		cmd := p.commandLine
+		p.commandLine = ""
+		return p.startBlock(cmd), true
+
+	case "D":
+		if !p.verify(oscParam(args, "nonce")) {
+			return nil, false
+		}
+		// The exit code is optional; D alone means it is unknown.
+		code, _, _ := strings.Cut(args, ";")
+		exitCode, _ := strconv.Atoi(code)
+		return p.endBlock(exitCode), true
+
+	default:
+		return nil, false
+	}
+}
+
+// startBlock opens a new block for cmd and returns its running chunk.
+func (p *blockParser) startBlock(cmd string) *OutputChunk {
+	p.commandID = p.newID()
+	return &OutputChunk{
+		CommandID: p.commandID,
+		Status:    CommandRunning,
+		Command:   cmd,
+	}
+}
+
+// endBlock closes the current block and returns its final chunk, or nil if
+// no block is open (e.g. an empty command line).
+func (p *blockParser) endBlock(exitCode int) *OutputChunk {
+	if p.commandID == "" {
+		return nil
+	}
+	chunk := &OutputChunk{
+		CommandID: p.commandID,
+		Status:    CommandCompleted,
+		ExitCode:  exitCode,
+	}
+	if exitCode != 0 {
+		chunk.Status = CommandFailed
+	}
+	p.commandID = ""
+	return chunk
+}
+
+// oscParam returns the value of a "key=value" parameter in the ';'-separated
+// arguments of an OSC 133/633 sequence, or "" if it is absent.
+func oscParam(args, key string) string {
+	for _, arg := range strings.Split(args, ";") {
+		if value, ok := strings.CutPrefix(arg, key+"="); ok {
+			return value
+		}
+	}
+	return ""
+}
+
+// decodeStartCommand decodes the base64 "cmd" field of a START marker.
+// Shells that cannot report the command line omit it, for which "" is
+// returned.
+func decodeStartCommand(encoded string) string {
+	if encoded == "" {
+		return ""
+	}
+	cmd, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimRight(string(cmd), "\r\n")
+}
+
+// decodeOSC633 reverses the OSC 633;E escaping, in which "\\" stands for a
+// backslash and "\xHH" for an arbitrary byte. Malformed escapes are kept
+// verbatim.
+func decodeOSC633(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			if s[i+1] == '\\' {
+				b.WriteByte('\\')
+				i++
+				continue
+			}
+			if s[i+1] == 'x' && i+3 < len(s) {
+				if v, err := strconv.ParseUint(s[i+2:i+4], 16, 8); err == nil {
+					b.WriteByte(byte(v))
+					i += 3
+					continue
+				}
+			}
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+// nextMarker returns the index and prefix of the earliest marker in buf, or
+// -1 if none starts in it.
+func nextMarker(buf []byte) (int, string) {
+	idx, found := -1, ""
+	for _, prefix := range markerPrefixes {
+		if i := bytes.Index(buf, []byte(prefix)); i != -1 && (idx == -1 || i < idx) {
+			idx, found = i, prefix
+		}
+	}
+	return idx, found
+}
+
+// dataChunk wraps output bytes with the metadata of the current block.
+func (p *blockParser) dataChunk(data []byte) OutputChunk {
+	chunk := OutputChunk{Data: append([]byte(nil), data...)}
+	if p.commandID != "" {
+		chunk.CommandID = p.commandID
+		chunk.Status = CommandRunning
+	}
+	return chunk
+}
+
+// partialSuffixLen returns the length of the longest suffix of buf that is a
+// proper prefix of needle, i.e. how many bytes might begin a split marker.
+func partialSuffixLen(buf []byte, needle string) int {
+	n := len(needle) - 1
+	if n > len(buf) {
+		n = len(buf)
+	}
+	for ; n > 0; n-- {
+		if bytes.HasSuffix(buf, []byte(needle[:n])) {
+			return n
+		}
+	}
+	return 0
+}
@@ -0,0 +1,243 @@
+package session
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+)
+
+const testNonce = "n0nce"
+
+// newTestParser returns a parser for testNonce that numbers its blocks
+// cmd1, cmd2, ...
+func newTestParser() *blockParser {
+	p := newBlockParser(testNonce)
+	n := 0
+	p.newID = func() string {
+		n++
+		return fmt.Sprintf("cmd%d", n)
+	}
+	return p
+}
+
+// parse feeds reads to a fresh parser, flushes it and describes the chunks
+// it produced, one string per chunk. Adjacent data chunks of the same block
+// are merged, since where output is cut does not matter.
+func parse(reads ...string) []string {
+	p := newTestParser()
+	var chunks []OutputChunk
+	for _, read := range reads {
+		chunks = append(chunks, p.Feed([]byte(read))...)
+	}
+	chunks = append(chunks, p.Flush()...)
+
+	var got []string
+	var data strings.Builder
+	dataBlock := ""
+	flushData := func() {
+		if data.Len() > 0 {
+			got = append(got, fmt.Sprintf("data %q %s", data.String(), dataBlock))
+			data.Reset()
+		}
+	}
+	for _, c := range chunks {
+		if len(c.Data) > 0 {
+			if c.CommandID != dataBlock {
+				flushData()
+				dataBlock = c.CommandID
+			}
+			data.Write(c.Data)
+			continue
+		}
+		flushData()
+		switch c.Status {
+		case CommandRunning:
+			got = append(got, fmt.Sprintf("start %s %q", c.CommandID, c.Command))
+		default:
+			got = append(got, fmt.Sprintf("%s %s %d", c.Status, c.CommandID, c.ExitCode))
+		}
+	}
+	flushData()
+	return got
+}
+
+func TestBlockParser(t *testing.T) {
+	// "ls -l" base64-encoded, as the START marker carries it.
+	start := "<<<BLOCKTERM:START nonce=" + testNonce + " cmd=bHMgLWw=>>>"
+
+	tests := []struct {
+		name  string
+		reads []string
+		want  []string
+	}{
+		{
+			name:  "plain output",
+			reads: []string{"hello\n"},
+			want:  []string{`data "hello\n" `},
+		},
+		{
+			name:  "blockterm markers",
+			reads: []string{"$ " + start + "file\n<<<BLOCKTERM:END nonce=" + testNonce + " exit=0>>>$ "},
+			want: []string{
+				`data "$ " `,
+				`start cmd1 "ls -l"`,
+				`data "file\n" cmd1`,
+				`completed cmd1 0`,
+				`data "$ " `,
+			},
+		},
+		{
+			name:  "blockterm failed block",
+			reads: []string{start, "<<<BLOCKTERM:END nonce=" + testNonce + " exit=2>>>"},
+			want:  []string{`start cmd1 "ls -l"`, `failed cmd1 2`},
+		},
+		{
+			name:  "missing nonce",
+			reads: []string{"<<<BLOCKTERM:START cmd=bHMgLWw=>>>x"},
+			want:  []string{`data "<<<BLOCKTERM:START cmd=bHMgLWw=>>>x" `},
+		},
+		{
+			name:  "wrong nonce",
+			reads: []string{start + "<<<BLOCKTERM:END nonce=forged exit=0>>>"},
+			want: []string{
+				`start cmd1 "ls -l"`,
+				`data "<<<BLOCKTERM:END nonce=forged exit=0>>>" cmd1`,
+			},
+		},
+		{
+			name:  "unknown marker passes through",
+			reads: []string{"<<<BLOCKTERM:PYENV 3.12>>>"},
+			want:  []string{`data "<<<BLOCKTERM:PYENV 3.12>>>" `},
+		},
+		{
+			name:  "end without a block",
+			reads: []string{"<<<BLOCKTERM:END nonce=" + testNonce + " exit=0>>>$ "},
+			want:  []string{`data "$ " `},
+		},
+		{
+			name: "osc 133",
+			reads: []string{
+				"\x1b]133;A\a$ \x1b]133;B\a",
+				"\x1b]133;C;nonce=" + testNonce + "\aout\n",
+				"\x1b]133;D;1;nonce=" + testNonce + "\a",
+			},
+			want: []string{
+				`data "$ " `,
+				`start cmd1 ""`,
+				`data "out\n" cmd1`,
+				`failed cmd1 1`,
+			},
+		},
+		{
+			name: "osc 133 without exit code, ST terminated",
+			reads: []string{
+				"\x1b]133;C;nonce=" + testNonce + "\x1b\\",
+				"\x1b]133;D;nonce=" + testNonce + "\x1b\\",
+			},
+			want: []string{`start cmd1 ""`, `completed cmd1 0`},
+		},
+		{
+			name: "osc 633 command line escapes",
+			reads: []string{
+				"\x1b]633;E;" + `echo a\x3bb \\n;` + testNonce + "\a",
+				"\x1b]633;C;nonce=" + testNonce + "\a",
+				"\x1b]633;D;0;nonce=" + testNonce + "\a",
+			},
+			want: []string{`start cmd1 "echo a;b \\n"`, `completed cmd1 0`},
+		},
+		{
+			name:  "osc 633 malformed escape kept",
+			reads: []string{"\x1b]633;E;" + `a\xZZ\` + ";" + testNonce + "\a\x1b]633;C;nonce=" + testNonce + "\a"},
+			want:  []string{`start cmd1 "a\\xZZ\\"`},
+		},
+		{
+			name: "osc 633 command line with wrong nonce",
+			reads: []string{
+				"\x1b]633;E;rm -rf /;forged\a",
+				"\x1b]633;C;nonce=" + testNonce + "\a",
+			},
+			want: []string{
+				`data "\x1b]633;E;rm -rf /;forged\a" `,
+				`start cmd1 ""`,
+			},
+		},
+		{
+			name:  "osc 133 wrong nonce",
+			reads: []string{"\x1b]133;C;nonce=forged\a"},
+			want:  []string{`data "\x1b]133;C;nonce=forged\a" `},
+		},
+		{
+			name:  "partial prefix that is not a marker",
+			reads: []string{"a<<<BLOCK", "ed\n"},
+			want:  []string{`data "a<<<BLOCKed\n" `},
+		},
+		{
+			name:  "partial prefix at EOF",
+			reads: []string{"a\x1b]13"},
+			want:  []string{`data "a\x1b]13" `},
+		},
+		{
+			name:  "unterminated marker at EOF",
+			reads: []string{"<<<BLOCKTERM:START nonce=" + testNonce},
+			want:  []string{`data "<<<BLOCKTERM:START nonce=` + testNonce + `" `},
+		},
+		{
+			name:  "unterminated marker longer than maxMarkerLen",
+			reads: []string{"<<<BLOCKTERM:", strings.Repeat("x", maxMarkerLen), "\n" + start},
+			want: []string{
+				`data "<<<BLOCKTERM:` + strings.Repeat("x", maxMarkerLen) + `\n" `,
+				`start cmd1 "ls -l"`,
+			},
+		},
+		{
+			name:  "unterminated osc longer than maxMarkerLen",
+			reads: []string{"\x1b]133;", strings.Repeat("x", maxMarkerLen), "\x1b]133;C;nonce=" + testNonce + "\a"},
+			want: []string{
+				`data "\x1b]133;` + strings.Repeat("x", maxMarkerLen) + `" `,
+				`start cmd1 ""`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parse(tt.reads...)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got:\n\t%s\nwant:\n\t%s", strings.Join(got, "\n\t"), strings.Join(tt.want, "\n\t"))
+			}
+		})
+	}
+}
+
+// TestBlockParserSplitReads checks that where the PTY cuts its reads does
+// not change the result, including cuts inside a marker's prefix.
+func TestBlockParserSplitReads(t *testing.T) {
+	streams := map[string]string{
+		"blockterm": "$ <<<BLOCKTERM:START nonce=" + testNonce + " cmd=bHMgLWw=>>>out\n" +
+			"<<<BLOCKTERM:END nonce=" + testNonce + " exit=3>>>$ ",
+		"osc 133": "\x1b]133;A\a$ \x1b]133;B\a\x1b]133;C;nonce=" + testNonce + "\aout\n" +
+			"\x1b]133;D;3;nonce=" + testNonce + "\x1b\\$ ",
+		"osc 633": "\x1b]633;A\a$ \x1b]633;E;ls -l;" + testNonce + "\a\x1b]633;C;nonce=" + testNonce + "\aout\n" +
+			"\x1b]633;D;3;nonce=" + testNonce + "\a$ ",
+	}
+
+	for name, stream := range streams {
+		t.Run(name, func(t *testing.T) {
+			want := parse(stream)
+			if len(want) != 5 {
+				t.Fatalf("whole stream parsed as %q, want 5 chunks", want)
+			}
+			for i := 1; i < len(stream); i++ {
+				got := parse(stream[:i], stream[i:])
+				if !slices.Equal(got, want) {
+					t.Fatalf("split at %d (%q | %q):\ngot  %q\nwant %q", i, stream[:i], stream[i:], got, want)
+				}
+			}
+			// One byte per read.
+			if got := parse(strings.Split(stream, "")...); !slices.Equal(got, want) {
+				t.Fatalf("byte by byte:\ngot  %q\nwant %q", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package session
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// procStat holds the fields of /proc/<pid>/stat the session package uses.
+type procStat struct {
+	PID   int
+	Comm  string // executable name, truncated by the kernel to 15 bytes
+	State byte   // R, S, D, Z, ...
+	PPID  int
+	PGID  int
+	SID   int
+	Start uint64 // start time in clock ticks since boot; tells reused PIDs apart
+}
+
+// readProcStat parses /proc/<pid>/stat.
+func readProcStat(pid int) (procStat, error) {
+	data, err := os.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err != nil {
+		return procStat{}, err
+	}
+
+	// "pid (comm) state ppid pgrp ..."; comm may itself contain spaces or
+	// parentheses, so split around the last ')'.
+	s := string(data)
+	open, closing := strings.IndexByte(s, '('), strings.LastIndexByte(s, ')')
+	if open == -1 || closing < open {
+		return procStat{}, fmt.Errorf("malformed stat for pid %d", pid)
+	}
+	// fields[i] is field i+3 of stat(5).
+	fields := strings.Fields(s[closing+1:])
+	if len(fields) < 20 || len(fields[0]) != 1 {
+		return procStat{}, fmt.Errorf("malformed stat for pid %d", pid)
+	}
+
+	st := procStat{PID: pid, Comm: s[open+1 : closing], State: fields[0][0]}
+	st.PPID, _ = strconv.Atoi(fields[1])
+	st.PGID, _ = strconv.Atoi(fields[2])
+	st.SID, _ = strconv.Atoi(fields[3])
+	st.Start, _ = strconv.ParseUint(fields[19], 10, 64)
+	return st, nil
+}
+
+// listProcs returns the stat of every process currently visible in /proc.
+// Processes that exit while being read are skipped.
+func listProcs() []procStat {
+	entries, err := os.ReadDir("/proc")
+	if err != nil {
+		return nil
+	}
+	var procs []procStat
+	for _, entry := range entries {
+		pid, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			continue
+		}
+		if st, err := readProcStat(pid); err == nil {
+			procs = append(procs, st)
+		}
+	}
+	return procs
+}
+
+// processInfo describes pid from /proc. Fields that cannot be read (the
+// process exited, or belongs to another user) are left empty.
+func processInfo(pid int) ProcessInfo {
+	info := ProcessInfo{PID: pid}
+	if st, err := readProcStat(pid); err == nil {
+		info.Name = st.Comm
+	}
+	if data, err := os.ReadFile(fmt.Sprintf("/proc/%d/cmdline", pid)); err == nil && len(data) > 0 {
+		info.Argv = strings.Split(strings.TrimSuffix(string(data), "\x00"), "\x00")
+	}
+	return info
+}
+
+// sessionProcesses returns the shell and every process it started: its
+// descendants, plus anything still in the terminal session it leads, which
+// catches jobs whose parent has already exited. Daemons that left the
+// session and were reparented cannot be traced back and are not included.
+func sessionProcesses(shellPID int) []procStat {
+	procs := listProcs()
+	children := make(map[int][]procStat)
+	for _, st := range procs {
+		children[st.PPID] = append(children[st.PPID], st)
+	}
+
+	seen := make(map[int]bool)
+	var found []procStat
+	var walk func(st procStat)
+	walk = func(st procStat) {
+		if seen[st.PID] {
+			return
+		}
+		seen[st.PID] = true
+		found = append(found, st)
+		for _, child := range children[st.PID] {
+			walk(child)
+		}
+	}
+	for _, st := range procs {
+		if st.PID == shellPID || st.SID == shellPID {
+			walk(st)
+		}
+	}
+
+	slices.SortFunc(found, func(a, b procStat) int { return a.PID - b.PID })
+	return found
+}
+
+// procAlive reports whether st is still running. Zombies count as gone, and
+// so does a different process that has since been given the same PID.
+func procAlive(st procStat) bool {
+	now, err := readProcStat(st.PID)
+	return err == nil && now.Start == st.Start && now.State != 'Z' && now.State != 'X'
+}
+
+// processGroupMembers returns the PIDs in process group pgid, sorted.
+func processGroupMembers(pgid int) []int {
+	var pids []int
+	for _, st := range listProcs() {
+		if st.PGID == pgid {
+			pids = append(pids, st.PID)
+		}
+	}
+	slices.Sort(pids)
+	return pids
+}
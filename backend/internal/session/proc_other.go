@@ -0,0 +1,37 @@
+//go:build !linux
+
+package session
+
+import (
+	"os"
+	"syscall"
+)
+
+// procStat is the little known about a process without /proc.
+type procStat struct {
+	PID  int
+	PGID int
+}
+
+// processInfo only knows the PID without /proc.
+func processInfo(pid int) ProcessInfo {
+	return ProcessInfo{PID: pid}
+}
+
+// processGroupMembers cannot enumerate the group without /proc; the group
+// leader, whose PID equals pgid, is the best available answer.
+func processGroupMembers(pgid int) []int {
+	return []int{pgid}
+}
+
+// sessionProcesses cannot discover descendants without /proc and returns
+// only the shell.
+func sessionProcesses(shellPID int) []procStat {
+	return []procStat{{PID: shellPID, PGID: shellPID}}
+}
+
+// procAlive probes the process with signal 0.
+func procAlive(st procStat) bool {
+	proc, err := os.FindProcess(st.PID)
+	return err == nil && proc.Signal(syscall.Signal(0)) == nil
+}
@@ -0,0 +1,120 @@
+package session
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/entl/blockterm/internal/recording"
+)
+
+// SetRecordingStore enables asciicast recording of sessions into store.
+func (m *Manager) SetRecordingStore(store *recording.Store) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.recordings = store
+}
+
+// StartRecording begins recording a session's output, input and resizes.
+// Event times are relative to the session's start. If the session is
+// already being recorded the existing recording is returned.
+func (m *Manager) StartRecording(sessionID string) (*recording.Writer, error) {
+	session, err := m.GetSession(sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.RLock()
+	store := m.recordings
+	m.mu.RUnlock()
+	if store == nil {
+		return nil, fmt.Errorf("recording is not configured")
+	}
+
+	session.mu.Lock()
+	defer session.mu.Unlock()
+
+	if session.State != StateRunning {
+		return nil, fmt.Errorf("session is not running: %s", sessionID)
+	}
+	if session.recording != nil {
+		return session.recording, nil
+	}
+
+	w, err := store.Create(session.ID, session.CreatedAt, session.Cols, session.Rows, recordingEnv(session.env, session.Shell))
+	if err != nil {
+		return nil, err
+	}
+	session.recording = w
+	return w, nil
+}
+
+// recordingEnv returns the environment a recording's header describes: the
+// TERM and SHELL of env, the last of several winning as in exec.Cmd. SHELL
+// falls back to the program the session runs.
+func recordingEnv(env []string, shell string) map[string]string {
+	header := map[string]string{"SHELL": shell}
+	for _, kv := range env {
+		key, value, ok := strings.Cut(kv, "=")
+		if ok && (key == "TERM" || key == "SHELL") {
+			header[key] = value
+		}
+	}
+	return header
+}
+
+// StopRecording finishes a session's recording, if any, and returns its ID.
+func (m *Manager) StopRecording(sessionID string) (string, error) {
+	session, err := m.GetSession(sessionID)
+	if err != nil {
+		return "", err
+	}
+	return session.stopRecording(), nil
+}
+
+// stopRecording finishes the session's recording and returns its ID, or ""
+// if the session was not being recorded.
+func (s *Session) stopRecording() string {
+	s.mu.Lock()
+	w := s.recording
+	s.recording = nil
+	s.mu.Unlock()
+
+	if w == nil {
+		return ""
+	}
+	if err := w.Close(); err != nil {
+		log.Printf("session %s: failed to close recording %s: %v", s.ID, w.ID(), err)
+	}
+	return w.ID()
+}
+
+// record tees one event into the session's recording, if any.
+func (s *Session) record(write func(w *recording.Writer) error) {
+	s.mu.RLock()
+	w := s.recording
+	s.mu.RUnlock()
+
+	if w == nil {
+		return
+	}
+	if err := write(w); err != nil && err != recording.ErrClosed {
+		log.Printf("session %s: failed to write recording: %v", s.ID, err)
+	}
+}
+
+// recordOutput, recordInput and recordResize tee PTY traffic into the
+// session's recording.
+func (s *Session) recordOutput(t time.Time, data []byte) {
+	s.record(func(w *recording.Writer) error { return w.Output(t, data) })
+}
+
+func (s *Session) recordInput(t time.Time, data []byte) {
+	s.record(func(w *recording.Writer) error { return w.Input(t, data) })
+}
+
+func (s *Session) recordResize(t time.Time, cols, rows int) {
+	s.record(func(w *recording.Writer) error { return w.Resize(t, cols, rows) })
+}
@@ -0,0 +1,304 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/entl/blockterm/gen/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultRunTimeout bounds a RunCommand that does not set a timeout.
+	defaultRunTimeout = time.Minute
+	// defaultRunOutputCap bounds each of stdout and stderr unless the
+	// caller asks for another limit.
+	defaultRunOutputCap = 1 << 20
+	// maxRunOutputCap is the largest output cap a caller may ask for.
+	maxRunOutputCap = 16 << 20
+	// runPipeWait bounds how long a finished command's output pipes may be
+	// held open by processes it left running.
+	runPipeWait = 500 * time.Millisecond
+)
+
+// errRunTimeout is the cause of a run's context ending at its own timeout,
+// as opposed to the caller's deadline.
+var errRunTimeout = errors.New("command timed out")
+
+// Output streams of a RunCommand.
+const (
+	StreamStdout = "stdout"
+	StreamStderr = "stderr"
+)
+
+// RunOptions describes a command line to run headlessly.
+type RunOptions struct {
+	Command string   // command line, as typed at a prompt
+	Shell   string   // Optional: override default shell
+	Args    []string // Optional: extra shell arguments
+	Login   bool     // Optional: run in a login shell
+	Cwd     string   // Optional: working directory
+	Env     []string // Optional: additional environment variables
+
+	StartupCommands []string      // Optional: run first, as in a session started from a profile
+	Timeout         time.Duration // Optional: kill the command after this long (default 1m)
+	MaxOutput       int           // Optional: bytes kept of each of stdout and stderr (default 1 MiB)
+}
+
+// RunResult is how a headless command ended and what it printed.
+type RunResult struct {
+	Exit     ExitStatus
+	TimedOut bool
+	Duration time.Duration
+
+	// Stdout and Stderr hold the output unless it was streamed.
+	Stdout, Stderr                   []byte
+	StdoutTruncated, StderrTruncated bool
+}
+
+// RunCommand runs a command line through the user's shell, with the same
+// startup files, aliases and PATH as a session, but without a visible
+// terminal: stdin is empty and stdout and stderr are captured separately.
+// If onOutput is set, output is passed to it as it arrives (never from two
+// goroutines at once) instead of being kept in the result. The command and
+// everything it started are killed when it times out or ctx is done.
+func RunCommand(ctx context.Context, opts RunOptions, onOutput func(stream string, data []byte)) (*RunResult, error) {
+	if strings.TrimSpace(opts.Command) == "" {
+		return nil, fmt.Errorf("command is required")
+	}
+	if opts.Shell == "" {
+		opts.Shell = defaultShell()
+	}
+	if opts.Cwd == "" {
+		opts.Cwd = homeDir()
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = defaultRunTimeout
+	}
+	if opts.MaxOutput <= 0 {
+		opts.MaxOutput = defaultRunOutputCap
+	}
+	opts.MaxOutput = min(opts.MaxOutput, maxRunOutputCap)
+
+	nonce, err := newMarkerNonce()
+	if err != nil {
+		return nil, err
+	}
+	script := strings.Join(append(slices.Clone(opts.StartupCommands), opts.Command), "\n")
+	shellPath, shellArgs, cleanup, err := prepareShellCommand(opts.Shell, MarkerProtocolBlockTerm, nonce, opts.Login, opts.Args, script)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare shell command: %w", err)
+	}
+	if cleanup != nil {
+		defer cleanup()
+	}
+
+	ctx, cancel := context.WithTimeoutCause(ctx, opts.Timeout, errRunTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, shellPath, shellArgs...)
+	cmd.Dir = opts.Cwd
+	// Programs should not decorate output nobody sees on a terminal.
+	cmd.Env = append(os.Environ(), getEnvSetup("dumb")...)
+	cmd.Env = append(cmd.Env, opts.Env...)
+	cmd.Cancel = func() error {
+		killProcessTree(cmd.Process.Pid)
+		return nil
+	}
+	cmd.WaitDelay = runPipeWait
+
+	out := &runOutput{max: opts.MaxOutput, onOutput: onOutput}
+	stdout := &cappedWriter{out: out, stream: StreamStdout}
+	stderr := &cappedWriter{out: out, stream: StreamStderr}
+	cmd.Stdout, cmd.Stderr = stdout, stderr
+
+	started, release, err := headlessTerminal(cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set up terminal: %w", err)
+	}
+	defer release()
+
+	start := time.Now()
+	if err := cmd.Start(); err != nil {
+		started()
+		return nil, fmt.Errorf("failed to start shell: %w", err)
+	}
+	started()
+
+	cmd.Wait()
+	duration := time.Since(start)
+	// Background jobs do not outlive the run.
+	killProcessTree(cmd.Process.Pid)
+
+	// Wait gives up on pipes held open past runPipeWait without stopping
+	// their readers; nothing they read is reported after this.
+	out.mu.Lock()
+	defer out.mu.Unlock()
+	out.closed = true
+	return &RunResult{
+		Exit:            exitStatusOf(cmd.ProcessState),
+		TimedOut:        context.Cause(ctx) == errRunTimeout,
+		Duration:        duration,
+		Stdout:          stdout.buf,
+		Stderr:          stderr.buf,
+		StdoutTruncated: stdout.truncated,
+		StderrTruncated: stderr.truncated,
+	}, nil
+}
+
+// runOutput is shared by the stdout and stderr of one run.
+type runOutput struct {
+	mu       sync.Mutex // serializes writers and onOutput
+	max      int        // bytes kept per stream
+	onOutput func(stream string, data []byte)
+	closed   bool // the run has returned
+}
+
+// cappedWriter keeps, or passes on, the first max bytes written to it and
+// discards the rest, so a chatty command cannot exhaust memory or stall on
+// a full pipe.
+type cappedWriter struct {
+	out       *runOutput
+	stream    string
+	buf       []byte
+	written   int
+	truncated bool
+}
+
+func (w *cappedWriter) Write(p []byte) (int, error) {
+	w.out.mu.Lock()
+	defer w.out.mu.Unlock()
+
+	if w.out.closed {
+		return len(p), nil
+	}
+	keep := p
+	if room := w.out.max - w.written; len(keep) > room {
+		keep = keep[:max(room, 0)]
+		w.truncated = true
+	}
+	w.written += len(keep)
+	if len(keep) > 0 {
+		if w.out.onOutput != nil {
+			w.out.onOutput(w.stream, append([]byte(nil), keep...))
+		} else {
+			w.buf = append(w.buf, keep...)
+		}
+	}
+	return len(p), nil
+}
+
+// killProcessTree kills a headless command's shell and everything still
+// running in its session.
+func killProcessTree(pid int) {
+	for _, st := range sessionProcesses(pid) {
+		if !procAlive(st) {
+			continue
+		}
+		if proc, err := os.FindProcess(st.PID); err == nil {
+			proc.Kill()
+		}
+	}
+}
+
+// RunCommand runs a command headlessly and returns its result once it has
+// finished.
+func (s *Service) RunCommand(ctx context.Context, req *pb.RunCommandRequest) (*pb.RunCommandResult, error) {
+	opts, err := s.runOptions(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := RunCommand(ctx, opts, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to run command: %v", err)
+	}
+	return toProtoRunResult(result), nil
+}
+
+// StreamCommand runs a command headlessly, streaming its output, and ends
+// the stream with its result.
+func (s *Service) StreamCommand(req *pb.RunCommandRequest, stream pb.TerminalService_StreamCommandServer) error {
+	ctx := stream.Context()
+	opts, err := s.runOptions(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	// A failed send stops the command rather than letting it run unseen.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var sendErr error
+	result, err := RunCommand(ctx, opts, func(name string, data []byte) {
+		if sendErr != nil {
+			return
+		}
+		sendErr = stream.Send(&pb.RunCommandEvent{Event: &pb.RunCommandEvent_Output{
+			Output: &pb.RunCommandOutput{Stream: name, Data: data},
+		}})
+		if sendErr != nil {
+			cancel()
+		}
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to run command: %v", err)
+	}
+	if sendErr != nil {
+		log.Printf("error streaming command output: %v", sendErr)
+		return status.Errorf(codes.Internal, "error streaming output: %v", sendErr)
+	}
+	return stream.Send(&pb.RunCommandEvent{Event: &pb.RunCommandEvent_Result{Result: toProtoRunResult(result)}})
+}
+
+// runOptions validates a run request and resolves its profile.
+func (s *Service) runOptions(ctx context.Context, req *pb.RunCommandRequest) (RunOptions, error) {
+	if strings.TrimSpace(req.Command) == "" {
+		return RunOptions{}, status.Error(codes.InvalidArgument, "command is required")
+	}
+
+	// Profiles describe sessions; resolve one the same way StartSession does.
+	session := SessionOptions{Shell: req.Shell, Args: req.Args, Cwd: req.Cwd}
+	if req.ProfileId != "" {
+		if err := s.applyProfile(ctx, req.ProfileId, &session); err != nil {
+			return RunOptions{}, err
+		}
+	}
+	for k, v := range req.Env {
+		session.Env = append(session.Env, fmt.Sprintf("%s=%s", k, v))
+	}
+
+	return RunOptions{
+		Command:         req.Command,
+		Shell:           session.Shell,
+		Args:            session.Args,
+		Login:           req.Login,
+		Cwd:             session.Cwd,
+		Env:             session.Env,
+		StartupCommands: session.StartupCommands,
+		Timeout:         time.Duration(req.TimeoutMs) * time.Millisecond,
+		MaxOutput:       int(req.MaxOutputBytes),
+	}, nil
+}
+
+// toProtoRunResult converts a run result into its wire form.
+func toProtoRunResult(r *RunResult) *pb.RunCommandResult {
+	return &pb.RunCommandResult{
+		ExitCode:        int32(r.Exit.Code),
+		Signal:          r.Exit.Signal,
+		TimedOut:        r.TimedOut,
+		DurationMs:      r.Duration.Milliseconds(),
+		Stdout:          r.Stdout,
+		Stderr:          r.Stderr,
+		StdoutTruncated: r.StdoutTruncated,
+		StderrTruncated: r.StderrTruncated,
+	}
+}
@@ -0,0 +1,11 @@
+//go:build !unix
+
+package session
+
+import "os/exec"
+
+// headlessTerminal is a no-op where processes have no controlling terminal
+// to give the command.
+func headlessTerminal(*exec.Cmd) (started, release func(), err error) {
+	return func() {}, func() {}, nil
+}
@@ -0,0 +1,28 @@
+//go:build unix
+
+package session
+
+import (
+	"os/exec"
+	"syscall"
+
+	"github.com/creack/pty"
+)
+
+// headlessTerminal gives a headless command a terminal of its own that it
+// never reads or writes: interactive shells need a controlling terminal for
+// job control, and the command must not share the server's. Its stdin stays
+// empty. started must be called once the command has started (or failed
+// to), and release once it has finished; closing the terminal hangs up
+// anything left in it.
+func headlessTerminal(cmd *exec.Cmd) (started, release func(), err error) {
+	ptmx, tty, err := pty.Open()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The terminal is the child's fd 3; stdin is left as /dev/null.
+	cmd.ExtraFiles = append(cmd.ExtraFiles, tty)
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setsid: true, Setctty: true, Ctty: 2 + len(cmd.ExtraFiles)}
+	return func() { tty.Close() }, func() { ptmx.Close() }, nil
+}
@@ -0,0 +1,93 @@
+package session
+
+// defaultScrollbackBytes is the scrollback retained per session when
+// SessionOptions.ScrollbackBytes is not set.
+const defaultScrollbackBytes = 1 << 20 // 1 MiB
+
+// chunkOverhead is the accounting cost charged for every retained chunk on
+// top of its data, so data-less block transitions cannot grow unbounded.
+const chunkOverhead = 64
+
+// scrollback is a bounded buffer of a session's parsed output chunks.
+// Chunks are addressed by the byte offset of their data within the session's
+// output stream and by a sequence number, so a client can resume from the
+// last offset or chunk it saw.
+// The oldest chunks are evicted once the retained size exceeds the limit.
+// It is not safe for concurrent use; callers hold Session.outputMu.
+type scrollback struct {
+	chunks []OutputChunk
+	size   int    // accounted bytes currently retained
+	limit  int    // maximum accounted bytes
+	end    int64  // offset one past the last byte ever written
+	seq    uint64 // sequence number of the last chunk ever written
+}
+
+// Position is where in a session's output a subscriber starts.
+type Position struct {
+	// Offset is the first byte wanted. Data-less chunks stamped with it
+	// are replayed too, since the client cannot tell which of them it saw.
+	Offset int64
+	// AfterSeq, if set, resumes after the chunk with this sequence number
+	// instead, so no chunk the client already has is sent again.
+	AfterSeq uint64
+}
+
+// newScrollback creates a scrollback buffer retaining up to limit bytes.
+func newScrollback(limit int) *scrollback {
+	if limit <= 0 {
+		limit = defaultScrollbackBytes
+	}
+	return &scrollback{limit: limit}
+}
+
+// Append stamps chunk with the current end offset and the next sequence
+// number, retains it and returns the stamped chunk.
+func (b *scrollback) Append(chunk OutputChunk) OutputChunk {
+	b.seq++
+	chunk.Seq = b.seq
+	chunk.Offset = b.end
+	b.end += int64(len(chunk.Data))
+
+	b.chunks = append(b.chunks, chunk)
+	b.size += len(chunk.Data) + chunkOverhead
+
+	for b.size > b.limit && len(b.chunks) > 1 {
+		b.size -= len(b.chunks[0].Data) + chunkOverhead
+		b.chunks[0] = OutputChunk{} // release the data for GC
+		b.chunks = b.chunks[1:]
+	}
+	return chunk
+}
+
+// Since returns the retained chunks from a position on. By offset, the first
+// chunk is trimmed if the offset falls inside it. If the position is older
+// than anything retained, replay starts at the oldest retained chunk;
+// callers can detect the gap by comparing the first chunk's Offset or Seq
+// with what they asked for.
+func (b *scrollback) Since(from Position) []OutputChunk {
+	var out []OutputChunk
+	if from.AfterSeq > 0 {
+		for _, chunk := range b.chunks {
+			if chunk.Seq > from.AfterSeq {
+				out = append(out, chunk)
+			}
+		}
+		return out
+	}
+
+	offset := from.Offset
+	for _, chunk := range b.chunks {
+		chunkEnd := chunk.Offset + int64(len(chunk.Data))
+		switch {
+		case chunkEnd < offset:
+			continue
+		case chunkEnd == offset && len(chunk.Data) > 0:
+			continue // fully consumed by the client already
+		case chunk.Offset < offset:
+			chunk.Data = chunk.Data[offset-chunk.Offset:]
+			chunk.Offset = offset
+		}
+		out = append(out, chunk)
+	}
+	return out
+}
@@ -0,0 +1,65 @@
+package session
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+// TestScrollbackResume checks where replay starts for each kind of
+// position, in particular that resuming after a sequence number does not
+// repeat data-less chunks stamped with the same offset.
+func TestScrollbackResume(t *testing.T) {
+	b := newScrollback(0)
+	for _, chunk := range []OutputChunk{
+		{Data: []byte("$ ")},                       // seq 1, offset 0
+		{CommandID: "c1", Status: CommandRunning},  // seq 2, offset 2
+		{Data: []byte("out\n"), CommandID: "c1"},   // seq 3, offset 2
+		{CommandID: "c1", Status: CommandFailed},   // seq 4, offset 6
+		{Resize: &WindowSize{Cols: 100, Rows: 30}}, // seq 5, offset 6
+		{Data: []byte("$ ")},                       // seq 6, offset 6
+	} {
+		b.Append(chunk)
+	}
+
+	tests := []struct {
+		name string
+		from Position
+		want []string
+	}{
+		{"everything", Position{}, []string{"1@0", "2@2", "3@2", "4@6", "5@6", "6@6"}},
+		{"inside a chunk", Position{Offset: 4}, []string{"3@4", "4@6", "5@6", "6@6"}},
+		{"at an offset with metadata", Position{Offset: 6}, []string{"4@6", "5@6", "6@6"}},
+		{"after a block end", Position{AfterSeq: 4}, []string{"5@6", "6@6"}},
+		{"after the last chunk", Position{AfterSeq: 6}, nil},
+		{"seq wins over offset", Position{Offset: 0, AfterSeq: 5}, []string{"6@6"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, chunk := range b.Since(tt.from) {
+				got = append(got, fmt.Sprintf("%d@%d", chunk.Seq, chunk.Offset))
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Since(%+v) = %q, want %q", tt.from, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestScrollbackResumeEvicted checks that resuming from a sequence number
+// that was evicted starts at the oldest retained chunk.
+func TestScrollbackResumeEvicted(t *testing.T) {
+	b := newScrollback(3 * (chunkOverhead + 1))
+	for i := 0; i < 10; i++ {
+		b.Append(OutputChunk{Data: []byte("x")})
+	}
+
+	replay := b.Since(Position{AfterSeq: 2})
+	if len(replay) != 3 {
+		t.Fatalf("replayed %d chunks, want 3", len(replay))
+	}
+	if replay[0].Seq != 8 {
+		t.Errorf("replay starts at seq %d, want 8", replay[0].Seq)
+	}
+}
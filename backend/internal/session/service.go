@@ -2,21 +2,32 @@ package session
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"log"
+	"math"
 	"time"
 
 	pb "github.com/entl/blockterm/gen/proto"
+	"github.com/entl/blockterm/internal/storage"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
+// ProfileStore looks up the profiles sessions can be started from. It is
+// satisfied by *storage.DB.
+type ProfileStore interface {
+	GetProfile(ctx context.Context, id string) (*storage.Profile, error)
+}
+
 // Service implements the gRPC TerminalServiceServer interface.
 // It bridges between the gRPC layer and the session Manager.
 type Service struct {
 	pb.UnimplementedTerminalServiceServer
-	manager *Manager
+	manager  *Manager
+	profiles ProfileStore
 }
 
 // NewService creates a new gRPC service for terminal sessions.
@@ -26,24 +37,63 @@ func NewService(manager *Manager) *Service {
 	}
 }
 
+// SetProfileStore lets StartSession start sessions from the profiles in
+// store. It must be called before the service is registered.
+func (s *Service) SetProfileStore(store ProfileStore) {
+	s.profiles = store
+}
+
 // StartSession creates a new PTY session and returns the session ID.
 func (s *Service) StartSession(ctx context.Context, req *pb.StartSessionRequest) (*pb.StartSessionResponse, error) {
+	protocol, err := ParseMarkerProtocol(req.MarkerProtocol)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if len(req.Command) > 0 && (req.Shell != "" || len(req.Args) > 0 || req.Login || req.ProfileId != "") {
+		return nil, status.Error(codes.InvalidArgument, "command cannot be combined with shell, args, login or profile_id")
+	}
+	if (req.Cols == 0) != (req.Rows == 0) {
+		return nil, status.Error(codes.InvalidArgument, "cols and rows must be set together")
+	}
+	if req.Cols > math.MaxUint16 || req.Rows > math.MaxUint16 {
+		return nil, status.Errorf(codes.InvalidArgument, "cols and rows must be at most %d", math.MaxUint16)
+	}
+
 	opts := SessionOptions{
-		Shell: req.Shell,
-		Cwd:   req.Cwd,
-		Cols:  80, // Default terminal size
-		Rows:  24,
+		Shell:          req.Shell,
+		Args:           req.Args,
+		Login:          req.Login,
+		Command:        req.Command,
+		Cwd:            req.Cwd,
+		Cols:           80, // Default terminal size
+		Rows:           24,
+		Term:           req.Term,
+		MarkerProtocol: protocol,
+		Record:         req.Record,
+	}
+	if req.Cols > 0 {
+		opts.Cols = int(req.Cols)
+		opts.Rows = int(req.Rows)
 	}
 
-	// Convert env map to slice
-	if len(req.Env) > 0 {
-		opts.Env = make([]string, 0, len(req.Env))
-		for k, v := range req.Env {
-			opts.Env = append(opts.Env, fmt.Sprintf("%s=%s", k, v))
+	if req.ProfileId != "" {
+		if err := s.applyProfile(ctx, req.ProfileId, &opts); err != nil {
+			return nil, err
 		}
+		opts.ProfileID = req.ProfileId
+	}
+
+	// Convert env map to slice; later entries win, so these override the
+	// profile's.
+	for k, v := range req.Env {
+		opts.Env = append(opts.Env, fmt.Sprintf("%s=%s", k, v))
 	}
 
 	session, err := s.manager.StartSession(opts)
+	if errors.Is(err, ErrTooManySessions) {
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	}
 	if err != nil {
 		log.Printf("failed to start session: %v", err)
 		return nil, status.Errorf(codes.Internal, "failed to start session: %v", err)
@@ -51,32 +101,75 @@ func (s *Service) StartSession(ctx context.Context, req *pb.StartSessionRequest)
 
 	log.Printf("started session: %s", session.ID)
 	return &pb.StartSessionResponse{
-		SessionId: session.ID,
+		SessionId:  session.ID,
+		OwnerToken: session.OwnerToken(),
 	}, nil
 }
 
-// CloseSession closes a session and cleans up resources.
-func (s *Service) CloseSession(ctx context.Context, req *pb.CloseSessionRequest) (*pb.Ack, error) {
+// applyProfile fills the options the request left unset from a stored
+// profile and adds its environment and startup commands.
+func (s *Service) applyProfile(ctx context.Context, profileID string, opts *SessionOptions) error {
+	if s.profiles == nil {
+		return status.Error(codes.FailedPrecondition, "profiles are not available")
+	}
+	profile, err := s.profiles.GetProfile(ctx, profileID)
+	if errors.Is(err, storage.ErrProfileNotFound) {
+		return status.Errorf(codes.NotFound, "profile not found: %s", profileID)
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to load profile: %v", err)
+	}
+
+	if opts.Shell == "" {
+		opts.Shell = profile.Shell
+	}
+	if len(opts.Args) == 0 {
+		opts.Args = profile.Args
+	}
+	if opts.Cwd == "" {
+		opts.Cwd = profile.Cwd
+	}
+	for k, v := range profile.Env {
+		opts.Env = append(opts.Env, fmt.Sprintf("%s=%s", k, v))
+	}
+	opts.StartupCommands = profile.StartupCommands
+	return nil
+}
+
+// CloseSession closes a session, terminating every process it started, and
+// reports what was killed.
+func (s *Service) CloseSession(ctx context.Context, req *pb.CloseSessionRequest) (*pb.CloseSessionResponse, error) {
 	if req.SessionId == "" {
 		return nil, status.Error(codes.InvalidArgument, "session_id is required")
 	}
 
-	err := s.manager.CloseSession(req.SessionId)
+	if err := s.authorizeOwner(req.SessionId, req.ShareToken); err != nil {
+		return nil, err
+	}
+
+	grace := time.Duration(req.GracePeriodMs) * time.Millisecond
+	killed, err := s.manager.CloseSession(req.SessionId, grace)
 	if err != nil {
 		log.Printf("failed to close session %s: %v", req.SessionId, err)
-		return nil, status.Errorf(codes.Internal, "failed to close session: %v", err)
+		return nil, status.Errorf(codes.NotFound, "failed to close session: %v", err)
 	}
 
-	log.Printf("closed session: %s", req.SessionId)
-	return &pb.Ack{Ok: true}, nil
+	resp := &pb.CloseSessionResponse{Ok: true}
+	for _, k := range killed {
+		resp.Killed = append(resp.Killed, &pb.KilledProcess{
+			Process:  toProtoProcessInfo(k.ProcessInfo),
+			Signal:   k.Signal,
+			Survived: k.Survived,
+		})
+	}
+	log.Printf("closed session: %s (%d processes terminated)", req.SessionId, len(killed))
+	return resp, nil
 }
 
 // SendInput receives a stream of input chunks from the client and writes them to the PTY.
 func (s *Service) SendInput(stream pb.TerminalService_SendInputServer) error {
 	for {
-		fmt.Printf("waiting for input chunk...\n")
 		chunk, err := stream.Recv()
-		fmt.Printf("received input chunk: %+v\n", chunk)
 		if err == io.EOF {
 			return stream.SendAndClose(&pb.Ack{Ok: true})
 		}
@@ -89,10 +182,15 @@ func (s *Service) SendInput(stream pb.TerminalService_SendInputServer) error {
 			return status.Error(codes.InvalidArgument, "session_id is required")
 		}
 
+		access, err := s.authorize(chunk.SessionId, chunk.ShareToken)
+		if err != nil {
+			return err
+		}
+
 		// Write input to session
-		if err := s.manager.WriteInput(chunk.SessionId, chunk.Data); err != nil {
+		if err := s.manager.WriteInputAs(chunk.SessionId, access, chunk.Data); err != nil {
 			log.Printf("error writing input to session %s: %v", chunk.SessionId, err)
-			return status.Errorf(codes.Internal, "error writing input: %v", err)
+			return accessError(err, codes.Internal, "error writing input: %v")
 		}
 	}
 }
@@ -103,81 +201,346 @@ func (s *Service) ReceiveOutput(req *pb.ReceiveOutputRequest, stream pb.Terminal
 		return status.Error(codes.InvalidArgument, "session_id is required")
 	}
 
-	session, err := s.manager.GetSession(req.SessionId)
+	access, err := s.authorize(req.SessionId, req.ShareToken)
 	if err != nil {
-		return status.Errorf(codes.NotFound, "session not found: %v", err)
+		return err
 	}
 
-	// Create a pipe to receive output from the session
-	pr, pw := io.Pipe()
-	defer pr.Close()
+	policy, err := ParseOverflowPolicy(req.OverflowPolicy)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
 
-	// Add the pipe writer as an output subscriber
-	if err := s.manager.AddOutputWriter(req.SessionId, pw); err != nil {
+	sub, replay, err := s.manager.Subscribe(req.SessionId, fromPosition(req.FromOffset, req.AfterSeq), policy)
+	if errors.Is(err, ErrSessionNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
 		return status.Errorf(codes.Internal, "failed to subscribe to output: %v", err)
 	}
+	defer s.manager.Unsubscribe(req.SessionId, sub)
+	defer s.manager.OpenStream(req.SessionId, access)()
 
-	// Stream output to client
-	buf := make([]byte, 4096)
-	done := make(chan error, 1)
-
-	// Read output in a goroutine to avoid blocking
-	go func() {
-		for {
-			n, err := pr.Read(buf)
-			if err != nil {
-				done <- err
-				return
-			}
-			if n > 0 {
-				chunk := &pb.OutputChunk{
-					SessionId: req.SessionId,
-					Data:      append([]byte(nil), buf[:n]...),
-				}
-				fmt.Printf("sending output chunk: %+v\n", chunk)
-				if err := stream.Send(chunk); err != nil {
-					done <- err
-					return
-				}
-			}
+	// Replay retained scrollback so a reconnecting client resumes where it
+	// left off (or sees everything produced before it first subscribed).
+	for _, chunk := range replay {
+		if err := stream.Send(toProtoChunk(chunk)); err != nil {
+			log.Printf("error replaying output for session %s: %v", req.SessionId, err)
+			return status.Errorf(codes.Internal, "error replaying output: %v", err)
 		}
-	}()
+	}
 
-	// Monitor session and context
-	ticker := time.NewTicker(100 * time.Millisecond)
-	defer ticker.Stop()
+	send := func(chunk OutputChunk) error {
+		if err := stream.Send(toProtoChunk(chunk)); err != nil {
+			log.Printf("error streaming output for session %s: %v", req.SessionId, err)
+			return status.Errorf(codes.Internal, "error streaming output: %v", err)
+		}
+		return nil
+	}
 
 	for {
 		select {
 		case <-stream.Context().Done():
-			pw.Close()
-			<-done
 			return stream.Context().Err()
 
-		case err := <-done:
-			pw.Close()
-			if err == io.EOF {
-				return nil
+		case <-access.Revoked():
+			log.Printf("session %s: share revoked, closing output stream", req.SessionId)
+			return status.Error(codes.PermissionDenied, "share revoked")
+
+		case <-sub.Done():
+			var exit *ExitError
+			switch err := sub.Err(); {
+			case errors.As(err, &exit):
+				// Pass on the shell's last output, then how it ended.
+				for len(sub.C) > 0 {
+					if err := send(<-sub.C); err != nil {
+						return err
+					}
+				}
+				log.Printf("session %s ended (%v), closing output stream", req.SessionId, exit)
+				return send(exitChunk(req.SessionId, exit))
+			case err != nil:
+				log.Printf("session %s: closing output stream: %v", req.SessionId, err)
+				return status.Error(codes.ResourceExhausted, err.Error())
 			}
-			log.Printf("error streaming output for session %s: %v", req.SessionId, err)
-			return status.Errorf(codes.Internal, "error streaming output: %v", err)
+			log.Printf("session %s detached, closing output stream", req.SessionId)
+			return nil
 
-		case <-ticker.C:
-			// Periodically check if session is still running
-			session.mu.RLock()
-			sessionState := session.State
-			session.mu.RUnlock()
-
-			if sessionState != StateRunning {
-				pw.Close()
-				<-done
-				log.Printf("session %s is no longer running, closing output stream", req.SessionId)
-				return nil
+		case chunk := <-sub.C:
+			if err := send(chunk); err != nil {
+				return err
 			}
 		}
 	}
 }
 
+// ListSessions returns a snapshot of every live session so a restarted
+// client can rediscover and re-attach to them. It includes each session's
+// owner token, which the restarted client no longer has; the server only
+// answers it for clients with the server token.
+func (s *Service) ListSessions(ctx context.Context, _ *emptypb.Empty) (*pb.ListSessionsResponse, error) {
+	sessions := s.manager.ListSessions()
+
+	resp := &pb.ListSessionsResponse{
+		Sessions: make([]*pb.SessionInfo, 0, len(sessions)),
+	}
+	for _, session := range sessions {
+		info := toProtoSessionInfo(session.Info())
+		info.OwnerToken = session.OwnerToken()
+		resp.Sessions = append(resp.Sessions, info)
+	}
+	return resp, nil
+}
+
+// GetSession returns a snapshot of a single session, including its
+// foreground process and alternate screen state.
+func (s *Service) GetSession(ctx context.Context, req *pb.GetSessionRequest) (*pb.SessionInfo, error) {
+	if req.SessionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "session_id is required")
+	}
+
+	if _, err := s.authorize(req.SessionId, req.ShareToken); err != nil {
+		return nil, err
+	}
+
+	session, err := s.manager.GetSession(req.SessionId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "session not found: %v", err)
+	}
+
+	return toProtoSessionInfo(session.Info()), nil
+}
+
+// DetachSession ends all output streams of a session but leaves the shell
+// running so another client can attach to it later.
+func (s *Service) DetachSession(ctx context.Context, req *pb.DetachSessionRequest) (*pb.Ack, error) {
+	if req.SessionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "session_id is required")
+	}
+
+	if err := s.authorizeOwner(req.SessionId, req.ShareToken); err != nil {
+		return nil, err
+	}
+
+	if err := s.manager.DetachSession(req.SessionId); err != nil {
+		return nil, status.Errorf(codes.NotFound, "session not found: %v", err)
+	}
+
+	log.Printf("detached session: %s", req.SessionId)
+	return &pb.Ack{Ok: true}, nil
+}
+
+// GetCwdHistory returns the directories a session has visited, as reported
+// by the shell integration via OSC 7.
+func (s *Service) GetCwdHistory(ctx context.Context, req *pb.GetCwdHistoryRequest) (*pb.GetCwdHistoryResponse, error) {
+	if req.SessionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "session_id is required")
+	}
+
+	if _, err := s.authorize(req.SessionId, req.ShareToken); err != nil {
+		return nil, err
+	}
+
+	session, err := s.manager.GetSession(req.SessionId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "session not found: %v", err)
+	}
+
+	history := session.CwdHistory()
+	resp := &pb.GetCwdHistoryResponse{
+		Entries: make([]*pb.CwdChange, 0, len(history)),
+	}
+	for _, change := range history {
+		resp.Entries = append(resp.Entries, &pb.CwdChange{
+			Path:      change.Path,
+			Timestamp: change.Time.Unix(),
+		})
+	}
+	return resp, nil
+}
+
+// SetRecording starts or stops the asciicast recording of a session.
+func (s *Service) SetRecording(ctx context.Context, req *pb.SetRecordingRequest) (*pb.SetRecordingResponse, error) {
+	if req.SessionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "session_id is required")
+	}
+
+	if err := s.authorizeOwner(req.SessionId, req.ShareToken); err != nil {
+		return nil, err
+	}
+
+	if !req.Enabled {
+		id, err := s.manager.StopRecording(req.SessionId)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to stop recording: %v", err)
+		}
+		return &pb.SetRecordingResponse{RecordingId: id}, nil
+	}
+
+	w, err := s.manager.StartRecording(req.SessionId)
+	if err != nil {
+		log.Printf("failed to start recording session %s: %v", req.SessionId, err)
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to start recording: %v", err)
+	}
+	log.Printf("recording session %s to %s", req.SessionId, w.Path())
+	return &pb.SetRecordingResponse{RecordingId: w.ID(), Path: w.Path()}, nil
+}
+
+// SignalSession sends a signal to the foreground process group of a session.
+func (s *Service) SignalSession(ctx context.Context, req *pb.SignalSessionRequest) (*pb.SignalSessionResponse, error) {
+	if req.SessionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "session_id is required")
+	}
+
+	sig, err := ParseSignal(req.Signal)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	access, err := s.authorize(req.SessionId, req.ShareToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := access.CheckWrite(); err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	pgid, pids, err := s.manager.SignalSession(req.SessionId, sig)
+	if err != nil {
+		log.Printf("failed to signal session %s: %v", req.SessionId, err)
+		return nil, status.Errorf(codes.Internal, "failed to signal session: %v", err)
+	}
+
+	log.Printf("sent %v to process group %d of session %s", sig, pgid, req.SessionId)
+	resp := &pb.SignalSessionResponse{
+		Pgid: int32(pgid),
+		Pids: make([]int32, 0, len(pids)),
+	}
+	for _, pid := range pids {
+		resp.Pids = append(resp.Pids, int32(pid))
+	}
+	return resp, nil
+}
+
+// toProtoSessionInfo converts a session snapshot into its wire representation.
+func toProtoSessionInfo(info SessionInfo) *pb.SessionInfo {
+	resp := &pb.SessionInfo{
+		SessionId:        info.ID,
+		Shell:            info.Shell,
+		Cwd:              info.Cwd,
+		Cols:             uint32(info.Cols),
+		Rows:             uint32(info.Rows),
+		State:            string(info.State),
+		CreatedAt:        info.CreatedAt.Unix(),
+		CurrentCommandId: info.CurrentCommandID,
+		CurrentCommand:   info.CurrentCommand,
+		CommandStatus:    info.CommandStatus,
+		CommandExitCode:  int32(info.CommandExitCode),
+		AttachedClients:  uint32(info.AttachedClients),
+		Foreground:       toProtoProcessInfo(info.Foreground),
+		AltScreen:        info.AltScreen,
+		RecordingId:      info.RecordingID,
+		OutputOffset:     uint64(info.OutputOffset),
+	}
+	for _, share := range info.Shares {
+		resp.Shares = append(resp.Shares, toProtoShare(share))
+	}
+	if !info.DetachedAt.IsZero() {
+		resp.DetachedAt = info.DetachedAt.Unix()
+	}
+	return resp
+}
+
+// fromPosition converts a request's resume point into a Position;
+// after_seq takes precedence over from_offset.
+func fromPosition(fromOffset, afterSeq uint64) Position {
+	return Position{Offset: int64(fromOffset), AfterSeq: afterSeq}
+}
+
+// toProtoChunk converts a parsed output chunk into its wire representation.
+func toProtoChunk(chunk OutputChunk) *pb.OutputChunk {
+	resp := &pb.OutputChunk{
+		SessionId: chunk.SessionID,
+		Data:      chunk.Data,
+		CommandId: chunk.CommandID,
+		Command:   chunk.Command,
+		Status:    chunk.Status,
+		ExitCode:  int32(chunk.ExitCode),
+		FailedBy:  chunk.FailedBy,
+		Offset:    uint64(chunk.Offset),
+		Seq:       chunk.Seq,
+		Dropped:   uint32(chunk.Dropped),
+	}
+	if chunk.Exit != nil {
+		resp.Exit = toProtoExit(*chunk.Exit)
+	}
+	if chunk.Event != nil {
+		resp.Event = toProtoEvent(*chunk.Event)
+	}
+	if chunk.Trigger != nil {
+		resp.Trigger = toProtoTriggerHit(*chunk.Trigger)
+	}
+	if chunk.Resize != nil {
+		resp.Resize = &pb.TerminalSize{Cols: uint32(chunk.Resize.Cols), Rows: uint32(chunk.Resize.Rows)}
+	}
+	if chunk.State != nil {
+		resp.State = &pb.SessionStateChange{
+			Foreground: toProtoProcessInfo(chunk.State.Foreground),
+			AltScreen:  chunk.State.AltScreen,
+		}
+	}
+	return resp
+}
+
+// toProtoExit converts how a session ended into its wire form.
+func toProtoExit(e ExitStatus) *pb.SessionExit {
+	return &pb.SessionExit{
+		ExitCode:     int32(e.Code),
+		Signal:       e.Signal,
+		ClosedByUser: e.ClosedByUser,
+		Reaped:       e.Reaped,
+	}
+}
+
+// toProtoEvent converts a session event into its wire form.
+func toProtoEvent(e SessionEvent) *pb.SessionEvent {
+	resp := &pb.SessionEvent{
+		SessionId: e.SessionID,
+		Kind:      e.Kind,
+		Reason:    e.Reason,
+	}
+	if !e.Deadline.IsZero() {
+		resp.Deadline = e.Deadline.UnixMilli()
+	}
+	if e.Trigger != nil {
+		resp.Trigger = toProtoTriggerHit(*e.Trigger)
+	}
+	return resp
+}
+
+// toProtoTriggerHit converts a trigger match into its wire form.
+func toProtoTriggerHit(h TriggerHit) *pb.TriggerHit {
+	return &pb.TriggerHit{
+		TriggerId: h.TriggerID,
+		Name:      h.Name,
+		Action:    h.Action,
+		Argument:  h.Argument,
+		Offset:    uint64(h.Offset),
+		Length:    uint32(len(h.Text)),
+		Text:      h.Text,
+		CommandId: h.CommandID,
+	}
+}
+
+// toProtoProcessInfo converts a process description into its wire form.
+func toProtoProcessInfo(p ProcessInfo) *pb.ProcessInfo {
+	return &pb.ProcessInfo{
+		Pid:  int32(p.PID),
+		Name: p.Name,
+		Argv: p.Argv,
+	}
+}
+
 // ResizeSession updates the terminal window size for a session.
 func (s *Service) ResizeSession(ctx context.Context, req *pb.ResizeSessionRequest) (*pb.Ack, error) {
 	if req.SessionId == "" {
@@ -188,10 +551,15 @@ func (s *Service) ResizeSession(ctx context.Context, req *pb.ResizeSessionReques
 		return nil, status.Error(codes.InvalidArgument, "cols and rows must be greater than 0")
 	}
 
-	err := s.manager.ResizeSession(req.SessionId, int(req.Cols), int(req.Rows))
+	access, err := s.authorize(req.SessionId, req.ShareToken)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.manager.ResizeAs(req.SessionId, access, int(req.Cols), int(req.Rows))
 	if err != nil {
 		log.Printf("failed to resize session %s: %v", req.SessionId, err)
-		return nil, status.Errorf(codes.Internal, "failed to resize session: %v", err)
+		return nil, accessError(err, codes.Internal, "failed to resize session: %v")
 	}
 
 	return &pb.Ack{Ok: true}, nil
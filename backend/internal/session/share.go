@@ -0,0 +1,433 @@
+package session
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	pb "github.com/entl/blockterm/gen/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Role is what a client attached to a session may do.
+type Role string
+
+const (
+	RoleOwner       Role = "owner"       // started the session; presents the owner token
+	RoleInteractive Role = "interactive" // types into and resizes the session alongside the owner
+	RoleReadOnly    Role = "read_only"   // only watches the output
+)
+
+// ParseRole validates a role a session can be shared with. An empty name
+// selects RoleReadOnly.
+func ParseRole(name string) (Role, error) {
+	switch Role(name) {
+	case "", RoleReadOnly:
+		return RoleReadOnly, nil
+	case RoleInteractive:
+		return RoleInteractive, nil
+	default:
+		return "", fmt.Errorf("unknown share role %q (want %q or %q)", name, RoleInteractive, RoleReadOnly)
+	}
+}
+
+var (
+	// ErrInvalidShareToken is returned for a missing token, or one that
+	// does not belong to the session or has been revoked.
+	ErrInvalidShareToken = errors.New("invalid share token")
+	// ErrPermissionDenied is returned when a client tries to do what its
+	// role does not allow, such as a read-only viewer changing a session.
+	ErrPermissionDenied = errors.New("permission denied")
+)
+
+// share is one grant of access to a session, handed to a collaborator as
+// its token.
+type share struct {
+	id        string
+	token     string
+	role      Role
+	createdAt time.Time
+	streams   int         // output streams open with the token
+	size      *WindowSize // last size the collaborator asked for
+	revoked   chan struct{}
+}
+
+// shareState is a session's owner token, its shares and the size its owner
+// asked for; the terminal gets the smallest size any interactive viewer can
+// show.
+type shareState struct {
+	ownerToken string
+	shares     map[string]*share // by ID
+	ownerSize  WindowSize
+}
+
+// ShareInfo describes a share without its token.
+type ShareInfo struct {
+	ID        string
+	Role      Role
+	CreatedAt time.Time
+	Streams   int
+	Size      *WindowSize
+}
+
+// Access is what one client may do to a session: the owner's rights, or
+// those of the share whose token it presented.
+type Access struct {
+	share *share
+}
+
+// Role returns the client's role.
+func (a Access) Role() Role {
+	if a.share == nil {
+		return RoleOwner
+	}
+	return a.share.role
+}
+
+// Revoked is closed once the share is revoked; it is nil for the owner.
+func (a Access) Revoked() <-chan struct{} {
+	if a.share == nil {
+		return nil
+	}
+	return a.share.revoked
+}
+
+// CheckWrite returns ErrPermissionDenied unless the client may change the
+// session: send input, resize or signal it.
+func (a Access) CheckWrite() error {
+	select {
+	case <-a.Revoked():
+		return fmt.Errorf("%w: share revoked", ErrPermissionDenied)
+	default:
+	}
+	if a.Role() == RoleReadOnly {
+		return fmt.Errorf("%w: session is shared read-only", ErrPermissionDenied)
+	}
+	return nil
+}
+
+// CheckOwner returns ErrPermissionDenied unless the client is the session's
+// owner, who alone may share, record, detach or close it.
+func (a Access) CheckOwner() error {
+	if a.Role() != RoleOwner {
+		return fmt.Errorf("%w: only the session's owner may do this", ErrPermissionDenied)
+	}
+	return nil
+}
+
+// newToken generates a secret for a client to present.
+func newToken() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(secret), nil
+}
+
+// ShareSession creates a share token for a session that lets one more
+// client attach to it with the given role. Tokens stay valid until revoked
+// or the session ends.
+func (m *Manager) ShareSession(sessionID string, role Role) (ShareInfo, string, error) {
+	session, err := m.GetSession(sessionID)
+	if err != nil {
+		return ShareInfo{}, "", err
+	}
+	if role != RoleInteractive && role != RoleReadOnly {
+		return ShareInfo{}, "", fmt.Errorf("cannot share a session as %q", role)
+	}
+
+	token, err := newToken()
+	if err != nil {
+		return ShareInfo{}, "", fmt.Errorf("failed to generate share token: %w", err)
+	}
+	sh := &share{
+		id:        uuid.New().String(),
+		token:     token,
+		role:      role,
+		createdAt: time.Now(),
+		revoked:   make(chan struct{}),
+	}
+
+	session.shareMu.Lock()
+	if session.share.shares == nil {
+		session.share.shares = make(map[string]*share)
+	}
+	session.share.shares[sh.id] = sh
+	session.shareMu.Unlock()
+
+	return sh.info(), sh.token, nil
+}
+
+// RevokeShare invalidates a share of a session, or every share if shareID
+// is empty, and ends the streams opened with it.
+func (m *Manager) RevokeShare(sessionID, shareID string) error {
+	session, err := m.GetSession(sessionID)
+	if err != nil {
+		return err
+	}
+
+	session.shareMu.Lock()
+	defer session.shareMu.Unlock()
+
+	if shareID != "" {
+		if _, ok := session.share.shares[shareID]; !ok {
+			return fmt.Errorf("share not found: %s", shareID)
+		}
+	}
+	for id, sh := range session.share.shares {
+		if shareID == "" || id == shareID {
+			close(sh.revoked)
+			delete(session.share.shares, id)
+		}
+	}
+	// The revoked viewers no longer hold the size down.
+	m.applyCommonSize(session, false)
+	return nil
+}
+
+// Authorize returns what a client presenting token, the session's owner
+// token or one of its share tokens, may do to a session.
+func (m *Manager) Authorize(sessionID, token string) (Access, error) {
+	session, err := m.GetSession(sessionID)
+	if err != nil {
+		return Access{}, err
+	}
+	if token == "" {
+		return Access{}, fmt.Errorf("%w: token required", ErrInvalidShareToken)
+	}
+
+	session.shareMu.Lock()
+	defer session.shareMu.Unlock()
+
+	if subtle.ConstantTimeCompare([]byte(session.share.ownerToken), []byte(token)) == 1 {
+		return Access{}, nil
+	}
+	for _, sh := range session.share.shares {
+		if subtle.ConstantTimeCompare([]byte(sh.token), []byte(token)) == 1 {
+			return Access{share: sh}, nil
+		}
+	}
+	return Access{}, ErrInvalidShareToken
+}
+
+// OpenStream registers an output stream opened with access. While it is
+// open, an interactive viewer's size counts towards the session's. The
+// caller must call the returned function once the stream ends.
+func (m *Manager) OpenStream(sessionID string, access Access) func() {
+	session, err := m.GetSession(sessionID)
+	if err != nil || access.share == nil {
+		return func() {}
+	}
+
+	session.shareMu.Lock()
+	access.share.streams++
+	if access.share.role == RoleInteractive && access.share.size != nil {
+		m.applyCommonSize(session, false)
+	}
+	session.shareMu.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			session.shareMu.Lock()
+			defer session.shareMu.Unlock()
+
+			access.share.streams--
+			if access.share.role == RoleInteractive && access.share.streams == 0 {
+				m.applyCommonSize(session, false)
+			}
+		})
+	}
+}
+
+// WriteInputAs writes input for a client, unless it only has read access.
+func (m *Manager) WriteInputAs(sessionID string, access Access, data []byte) error {
+	if err := access.CheckWrite(); err != nil {
+		return err
+	}
+	return m.WriteInput(sessionID, data)
+}
+
+// ResizeAs records the size a client's terminal shows the session at and
+// resizes the session to the smallest size among the owner and the
+// interactive viewers, so every one of them sees the whole screen.
+// Read-only viewers cannot resize.
+func (m *Manager) ResizeAs(sessionID string, access Access, cols, rows int) error {
+	if err := access.CheckWrite(); err != nil {
+		return err
+	}
+	session, err := m.GetSession(sessionID)
+	if err != nil {
+		return err
+	}
+
+	session.shareMu.Lock()
+	defer session.shareMu.Unlock()
+
+	size := WindowSize{Cols: cols, Rows: rows}
+	if access.share == nil {
+		session.share.ownerSize = size
+	} else {
+		access.share.size = &size
+	}
+	return m.applyCommonSize(session, true)
+}
+
+// applyCommonSize resizes a session to the size every interactive viewer
+// can show. Unless force is set, nothing happens if the size is unchanged.
+// The caller must hold session.shareMu.
+func (m *Manager) applyCommonSize(session *Session, force bool) error {
+	size := session.share.ownerSize
+	for _, sh := range session.share.shares {
+		if sh.role != RoleInteractive || sh.streams == 0 || sh.size == nil {
+			continue
+		}
+		size.Cols = min(size.Cols, sh.size.Cols)
+		size.Rows = min(size.Rows, sh.size.Rows)
+	}
+
+	session.mu.RLock()
+	unchanged := session.Cols == size.Cols && session.Rows == size.Rows
+	session.mu.RUnlock()
+	if unchanged && !force {
+		return nil
+	}
+
+	err := m.setSize(session, size.Cols, size.Rows)
+	if err != nil && !force {
+		log.Printf("session %s: failed to resize for viewers: %v", session.ID, err)
+	}
+	return err
+}
+
+// OwnerToken returns the token that authorizes its holder as the session's
+// owner. It is set when the session starts and never changes.
+func (s *Session) OwnerToken() string { return s.share.ownerToken }
+
+// shareInfo lists a session's shares, oldest first.
+func (s *Session) shareInfo() []ShareInfo {
+	s.shareMu.Lock()
+	defer s.shareMu.Unlock()
+
+	shares := make([]ShareInfo, 0, len(s.share.shares))
+	for _, sh := range s.share.shares {
+		shares = append(shares, sh.info())
+	}
+	sort.Slice(shares, func(i, j int) bool {
+		return shares[i].CreatedAt.Before(shares[j].CreatedAt)
+	})
+	return shares
+}
+
+// info describes the share. The caller must hold the session's shareMu
+// unless the share is not yet visible to others.
+func (sh *share) info() ShareInfo {
+	info := ShareInfo{ID: sh.id, Role: sh.role, CreatedAt: sh.createdAt, Streams: sh.streams}
+	if sh.size != nil {
+		size := *sh.size
+		info.Size = &size
+	}
+	return info
+}
+
+// authorize resolves a request's share token for a session into a gRPC
+// status error if the session or token is unknown.
+func (s *Service) authorize(sessionID, token string) (Access, error) {
+	access, err := s.manager.Authorize(sessionID, token)
+	if errors.Is(err, ErrInvalidShareToken) {
+		return Access{}, status.Error(codes.PermissionDenied, err.Error())
+	}
+	if err != nil {
+		return Access{}, status.Error(codes.NotFound, err.Error())
+	}
+	return access, nil
+}
+
+// authorizeOwner is authorize for requests only the session's owner may
+// make.
+func (s *Service) authorizeOwner(sessionID, token string) error {
+	access, err := s.authorize(sessionID, token)
+	if err != nil {
+		return err
+	}
+	if err := access.CheckOwner(); err != nil {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return nil
+}
+
+// ShareSession creates a token another client can attach to a session with.
+func (s *Service) ShareSession(ctx context.Context, req *pb.ShareSessionRequest) (*pb.ShareSessionResponse, error) {
+	if req.SessionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "session_id is required")
+	}
+	role, err := ParseRole(req.Role)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := s.authorizeOwner(req.SessionId, req.ShareToken); err != nil {
+		return nil, err
+	}
+
+	info, token, err := s.manager.ShareSession(req.SessionId, role)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "failed to share session: %v", err)
+	}
+
+	log.Printf("shared session %s as %s (share %s)", req.SessionId, role, info.ID)
+	return &pb.ShareSessionResponse{
+		Share:      toProtoShare(info),
+		ShareToken: token,
+	}, nil
+}
+
+// RevokeShare invalidates one or all share tokens of a session and
+// disconnects the viewers that used them.
+func (s *Service) RevokeShare(ctx context.Context, req *pb.RevokeShareRequest) (*pb.Ack, error) {
+	if req.SessionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "session_id is required")
+	}
+	if err := s.authorizeOwner(req.SessionId, req.ShareToken); err != nil {
+		return nil, err
+	}
+
+	if err := s.manager.RevokeShare(req.SessionId, req.ShareId); err != nil {
+		return nil, status.Errorf(codes.NotFound, "failed to revoke share: %v", err)
+	}
+
+	log.Printf("revoked shares of session %s", req.SessionId)
+	return &pb.Ack{Ok: true}, nil
+}
+
+// toProtoShare converts a share description into its wire form.
+func toProtoShare(info ShareInfo) *pb.SessionShare {
+	resp := &pb.SessionShare{
+		ShareId:   info.ID,
+		Role:      string(info.Role),
+		CreatedAt: info.CreatedAt.Unix(),
+		Streams:   uint32(info.Streams),
+	}
+	if info.Size != nil {
+		resp.Size = &pb.TerminalSize{Cols: uint32(info.Size.Cols), Rows: uint32(info.Size.Rows)}
+	}
+	return resp
+}
+
+// accessError converts an error from acting for a client into a gRPC
+// status, with PermissionDenied for what its role does not allow.
+func accessError(err error, code codes.Code, format string) error {
+	if errors.Is(err, ErrPermissionDenied) {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return status.Errorf(code, format, err)
+}
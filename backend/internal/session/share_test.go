@@ -0,0 +1,388 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/creack/pty"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	pb "github.com/entl/blockterm/gen/proto"
+)
+
+// newSharedSession is newTestSession backed by a real PTY, which input and
+// resizes need, with "owner" as its owner token and an 80x24 terminal.
+func newSharedSession(t *testing.T, m *Manager) *Session {
+	t.Helper()
+	session, _ := newTestSession(t, m)
+	ptmx, tty, err := pty.Open()
+	if err != nil {
+		t.Skipf("no PTY available: %v", err)
+	}
+	t.Cleanup(func() { ptmx.Close(); tty.Close() })
+
+	session.PTY = ptmx
+	session.Cols, session.Rows = 80, 24
+	session.share = shareState{ownerToken: "owner", ownerSize: WindowSize{Cols: 80, Rows: 24}}
+	return session
+}
+
+// newInputSession registers a running session whose "PTY" is the write end
+// of a pipe, and returns the read end, where input written to it arrives.
+func newInputSession(t *testing.T, m *Manager, id string) (*Session, *os.File) {
+	t.Helper()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { r.Close(); w.Close() })
+
+	session := &Session{
+		ID:         id,
+		PTY:        w,
+		State:      StateRunning,
+		CreatedAt:  time.Now(),
+		parser:     newBlockParser(""),
+		scrollback: newScrollback(0),
+		outputDone: make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+	m.mu.Lock()
+	m.sessions[session.ID] = session
+	m.mu.Unlock()
+	return session, r
+}
+
+// newShare creates a share of session and returns its ID and token.
+func newShare(t *testing.T, m *Manager, session *Session, role Role) (string, string) {
+	t.Helper()
+	info, token, err := m.ShareSession(session.ID, role)
+	if err != nil {
+		t.Fatalf("ShareSession: %v", err)
+	}
+	return info.ID, token
+}
+
+// TestAuthorize checks that only the owner token and live share tokens are
+// accepted, each with its own role.
+func TestAuthorize(t *testing.T) {
+	m := NewManager()
+	session := newSharedSession(t, m)
+	_, interactive := newShare(t, m, session, RoleInteractive)
+	_, readOnly := newShare(t, m, session, RoleReadOnly)
+
+	tests := []struct {
+		name      string
+		sessionID string
+		token     string
+		role      Role
+		err       error
+	}{
+		{"owner token", session.ID, "owner", RoleOwner, nil},
+		{"interactive share", session.ID, interactive, RoleInteractive, nil},
+		{"read-only share", session.ID, readOnly, RoleReadOnly, nil},
+		{"no token", session.ID, "", "", ErrInvalidShareToken},
+		{"unknown token", session.ID, "owner2", "", ErrInvalidShareToken},
+		{"unknown session", "missing", "owner", "", ErrSessionNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			access, err := m.Authorize(tt.sessionID, tt.token)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Authorize = %v, want %v", err, tt.err)
+			}
+			if err == nil && access.Role() != tt.role {
+				t.Errorf("role = %s, want %s", access.Role(), tt.role)
+			}
+		})
+	}
+}
+
+// TestShareRoles checks what each role may do to a session.
+func TestShareRoles(t *testing.T) {
+	tests := []struct {
+		role     Role
+		canWrite bool
+		isOwner  bool
+	}{
+		{RoleOwner, true, true},
+		{RoleInteractive, true, false},
+		{RoleReadOnly, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.role), func(t *testing.T) {
+			m := NewManager()
+			session := newSharedSession(t, m)
+			token := "owner"
+			if tt.role != RoleOwner {
+				_, token = newShare(t, m, session, tt.role)
+			}
+			access, err := m.Authorize(session.ID, token)
+			if err != nil {
+				t.Fatalf("Authorize: %v", err)
+			}
+
+			checks := map[string]error{
+				"CheckWrite":   access.CheckWrite(),
+				"WriteInputAs": m.WriteInputAs(session.ID, access, []byte("x")),
+				"ResizeAs":     m.ResizeAs(session.ID, access, 100, 30),
+			}
+			for name, err := range checks {
+				if denied := errors.Is(err, ErrPermissionDenied); denied == tt.canWrite || (err != nil && !denied) {
+					t.Errorf("%s = %v, want allowed %v", name, err, tt.canWrite)
+				}
+			}
+			if err := access.CheckOwner(); (err == nil) != tt.isOwner {
+				t.Errorf("CheckOwner = %v, want owner %v", err, tt.isOwner)
+			}
+		})
+	}
+}
+
+// TestRevokeShare checks that a revoked token stops working, including for
+// clients that authorized with it before, while other shares keep theirs.
+func TestRevokeShare(t *testing.T) {
+	m := NewManager()
+	session := newSharedSession(t, m)
+	revokedID, revokedToken := newShare(t, m, session, RoleInteractive)
+	_, keptToken := newShare(t, m, session, RoleInteractive)
+
+	access, err := m.Authorize(session.ID, revokedToken)
+	if err != nil {
+		t.Fatalf("Authorize: %v", err)
+	}
+	if err := m.RevokeShare(session.ID, revokedID); err != nil {
+		t.Fatalf("RevokeShare: %v", err)
+	}
+
+	select {
+	case <-access.Revoked():
+	default:
+		t.Error("Revoked not closed")
+	}
+	if err := m.WriteInputAs(session.ID, access, []byte("x")); !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("WriteInputAs after revoke = %v, want ErrPermissionDenied", err)
+	}
+	if _, err := m.Authorize(session.ID, revokedToken); !errors.Is(err, ErrInvalidShareToken) {
+		t.Errorf("Authorize with revoked token = %v, want ErrInvalidShareToken", err)
+	}
+	if _, err := m.Authorize(session.ID, keptToken); err != nil {
+		t.Errorf("Authorize with other token = %v, want nil", err)
+	}
+	if err := m.RevokeShare(session.ID, revokedID); err == nil {
+		t.Error("revoking a share twice succeeded")
+	}
+
+	// An empty share ID revokes the rest; the owner is unaffected.
+	if err := m.RevokeShare(session.ID, ""); err != nil {
+		t.Fatalf("RevokeShare(all): %v", err)
+	}
+	if _, err := m.Authorize(session.ID, keptToken); !errors.Is(err, ErrInvalidShareToken) {
+		t.Errorf("Authorize after revoking all = %v, want ErrInvalidShareToken", err)
+	}
+	if _, err := m.Authorize(session.ID, "owner"); err != nil {
+		t.Errorf("Authorize as owner = %v, want nil", err)
+	}
+}
+
+// TestSharedSize checks that an interactive viewer's size only holds the
+// terminal down while it has a stream open and its share is live.
+func TestSharedSize(t *testing.T) {
+	m := NewManager()
+	session := newSharedSession(t, m)
+	shareID, token := newShare(t, m, session, RoleInteractive)
+	access, err := m.Authorize(session.ID, token)
+	if err != nil {
+		t.Fatalf("Authorize: %v", err)
+	}
+
+	size := func(want WindowSize) {
+		t.Helper()
+		if info := session.Info(); info.Cols != want.Cols || info.Rows != want.Rows {
+			t.Errorf("size = %dx%d, want %dx%d", info.Cols, info.Rows, want.Cols, want.Rows)
+		}
+	}
+
+	if err := m.ResizeAs(session.ID, access, 60, 20); err != nil {
+		t.Fatalf("ResizeAs: %v", err)
+	}
+	size(WindowSize{Cols: 80, Rows: 24}) // no stream open yet
+
+	release := m.OpenStream(session.ID, access)
+	size(WindowSize{Cols: 60, Rows: 20})
+	release()
+	size(WindowSize{Cols: 80, Rows: 24})
+
+	m.OpenStream(session.ID, access)
+	size(WindowSize{Cols: 60, Rows: 20})
+	if err := m.RevokeShare(session.ID, shareID); err != nil {
+		t.Fatalf("RevokeShare: %v", err)
+	}
+	size(WindowSize{Cols: 80, Rows: 24})
+}
+
+// TestBroadcastGroupAccess checks that group members need write access,
+// that input is written with each member's access, and that the group
+// token is required.
+func TestBroadcastGroupAccess(t *testing.T) {
+	m := NewManager()
+	session := newSharedSession(t, m)
+	shareID, interactive := newShare(t, m, session, RoleInteractive)
+	_, readOnly := newShare(t, m, session, RoleReadOnly)
+
+	member := func(token string) []GroupMember {
+		t.Helper()
+		access, err := m.Authorize(session.ID, token)
+		if err != nil {
+			t.Fatalf("Authorize: %v", err)
+		}
+		return []GroupMember{{SessionID: session.ID, Access: access}}
+	}
+
+	if _, _, err := m.CreateBroadcastGroup("ro", member(readOnly)); !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("CreateBroadcastGroup with a read-only member = %v, want ErrPermissionDenied", err)
+	}
+	group, token, err := m.CreateBroadcastGroup("rw", member(interactive))
+	if err != nil {
+		t.Fatalf("CreateBroadcastGroup: %v", err)
+	}
+
+	if _, _, err := m.WriteGroupInput(group.ID, "", []byte("x")); !errors.Is(err, ErrInvalidGroupToken) {
+		t.Errorf("WriteGroupInput without the group token = %v, want ErrInvalidGroupToken", err)
+	}
+	if err := m.DeleteBroadcastGroup(group.ID, "wrong"); !errors.Is(err, ErrInvalidGroupToken) {
+		t.Errorf("DeleteBroadcastGroup with a wrong token = %v, want ErrInvalidGroupToken", err)
+	}
+	if delivered, failures, err := m.WriteGroupInput(group.ID, token, []byte("x")); err != nil || delivered != 1 || len(failures) != 0 {
+		t.Errorf("WriteGroupInput = %d, %v, %v; want 1 delivered", delivered, failures, err)
+	}
+
+	if err := m.RevokeShare(session.ID, shareID); err != nil {
+		t.Fatalf("RevokeShare: %v", err)
+	}
+	delivered, failures, err := m.WriteGroupInput(group.ID, token, []byte("x"))
+	if err != nil || delivered != 0 || len(failures) != 1 || !errors.Is(failures[0].Err, ErrPermissionDenied) {
+		t.Errorf("WriteGroupInput after revoke = %d, %v, %v; want the member denied", delivered, failures, err)
+	}
+
+	if err := m.DeleteBroadcastGroup(group.ID, token); err != nil {
+		t.Errorf("DeleteBroadcastGroup: %v", err)
+	}
+}
+
+// TestBroadcastSlowMember checks that a member whose terminal does not take
+// input neither holds up the caller nor the other members, and that input
+// for it is dropped once its queue is full.
+func TestBroadcastSlowMember(t *testing.T) {
+	m := NewManager()
+	_, fast := newInputSession(t, m, "fast")
+	newInputSession(t, m, "slow") // nobody reads its input
+	group, token, err := m.CreateBroadcastGroup("all", []GroupMember{{SessionID: "fast"}, {SessionID: "slow"}})
+	if err != nil {
+		t.Fatalf("CreateBroadcastGroup: %v", err)
+	}
+
+	// Each chunk must reach the fast member before the next is sent, long
+	// after the slow one's pipe and queue are full.
+	const chunks, chunkSize = 200, 4096
+	chunk, got := make([]byte, chunkSize), make([]byte, chunkSize)
+	busy := 0
+	for i := range chunks {
+		delivered, failures, err := m.WriteGroupInput(group.ID, token, chunk)
+		if err != nil {
+			t.Fatalf("WriteGroupInput %d: %v", i, err)
+		}
+		for _, f := range failures {
+			if f.SessionID != "slow" || !errors.Is(f.Err, ErrMemberBusy) {
+				t.Fatalf("WriteGroupInput %d failed for %s: %v", i, f.SessionID, f.Err)
+			}
+			busy++
+		}
+		if delivered+len(failures) != 2 {
+			t.Fatalf("WriteGroupInput %d = %d delivered, %d failed; want 2 members", i, delivered, len(failures))
+		}
+
+		fast.SetReadDeadline(time.Now().Add(5 * time.Second))
+		if _, err := io.ReadFull(fast, got); err != nil {
+			t.Fatalf("fast member, chunk %d: %v", i, err)
+		}
+	}
+	if busy == 0 {
+		t.Error("the slow member never reported a full queue")
+	}
+}
+
+// TestBroadcastGroupPrunesClosedSessions checks that a closed session
+// leaves the groups it was in.
+func TestBroadcastGroupPrunesClosedSessions(t *testing.T) {
+	m := NewManager()
+	newInputSession(t, m, "a")
+	newInputSession(t, m, "b")
+	group, token, err := m.CreateBroadcastGroup("ab", []GroupMember{{SessionID: "a"}, {SessionID: "b"}})
+	if err != nil {
+		t.Fatalf("CreateBroadcastGroup: %v", err)
+	}
+
+	if _, err := m.CloseSession("a", time.Millisecond); err != nil {
+		t.Fatalf("CloseSession: %v", err)
+	}
+	groups := m.ListBroadcastGroups()
+	if len(groups) != 1 || !slices.Equal(groups[0].SessionIDs, []string{"b"}) {
+		t.Fatalf("groups = %+v, want only b left in %s", groups, group.ID)
+	}
+	if delivered, failures, err := m.WriteGroupInput(group.ID, token, []byte("x")); err != nil || delivered != 1 || len(failures) != 0 {
+		t.Errorf("WriteGroupInput = %d, %v, %v; want 1 delivered", delivered, failures, err)
+	}
+}
+
+// TestOwnerTokenAfterReconnect checks that a client which lost the owner
+// token of a session it started, by restarting, gets it back from
+// ListSessions and can use it.
+func TestOwnerTokenAfterReconnect(t *testing.T) {
+	m := NewManager()
+	t.Cleanup(func() { m.Close() })
+	svc := NewService(m)
+	ctx := context.Background()
+
+	started, err := svc.StartSession(ctx, &pb.StartSessionRequest{Command: []string{"sleep", "30"}})
+	if err != nil {
+		t.Fatalf("StartSession: %v", err)
+	}
+
+	// The restarted client only knows what ListSessions tells it.
+	list, err := svc.ListSessions(ctx, &emptypb.Empty{})
+	if err != nil {
+		t.Fatalf("ListSessions: %v", err)
+	}
+	var token string
+	for _, info := range list.Sessions {
+		if info.SessionId == started.SessionId {
+			token = info.OwnerToken
+		}
+	}
+	if token != started.OwnerToken {
+		t.Fatalf("owner token from ListSessions = %q, want the one StartSession returned", token)
+	}
+
+	if _, err := svc.GetSession(ctx, &pb.GetSessionRequest{SessionId: started.SessionId, ShareToken: token}); err != nil {
+		t.Errorf("GetSession with the recovered token: %v", err)
+	}
+	shared, err := svc.ShareSession(ctx, &pb.ShareSessionRequest{SessionId: started.SessionId, ShareToken: token})
+	if err != nil {
+		t.Fatalf("ShareSession with the recovered token: %v", err)
+	}
+
+	// GetSession is open to share tokens and must not hand the owner's out.
+	info, err := svc.GetSession(ctx, &pb.GetSessionRequest{SessionId: started.SessionId, ShareToken: shared.ShareToken})
+	if err != nil {
+		t.Fatalf("GetSession with a share token: %v", err)
+	}
+	if info.OwnerToken != "" {
+		t.Error("GetSession returned the owner token to a share")
+	}
+}
@@ -1,21 +1,64 @@
 package session
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 )
 
 // ShellMarkers defines unique markers for detecting command boundaries.
 // Plain-text ASCII sequences are used so they survive any terminal
 // transport without being swallowed by escape-sequence strippers.
 type ShellMarkers struct {
-	CommandStart string // Marker printed immediately before command output
+	CommandStart string // Marker printed immediately before command output (may carry " cmd=<base64>")
 	CommandEnd   string // Marker printed after command finishes (fmt.Sprintf with exit code)
 	PromptStart  string // Marker printed when the prompt is displayed
 }
 
-// DefaultMarkers returns the default shell markers.
+// MarkerProtocol selects the sequences the shell integration uses to report
+// command boundaries. The parser accepts both, whichever one a session uses,
+// so output from a remote host with its own integration is understood too.
+type MarkerProtocol string
+
+const (
+	// MarkerProtocolBlockTerm uses the plain-text <<<BLOCKTERM:...>>> markers.
+	MarkerProtocolBlockTerm MarkerProtocol = "blockterm"
+	// MarkerProtocolOSC133 uses the OSC 133 semantic prompt sequences (A, C,
+	// D) plus OSC 633;E for the command line. Programs capturing the PTY
+	// (script, tmux, ssh) ignore these instead of printing them.
+	MarkerProtocolOSC133 MarkerProtocol = "osc133"
+)
+
+// ParseMarkerProtocol parses a protocol name; "" selects the default.
+func ParseMarkerProtocol(name string) (MarkerProtocol, error) {
+	switch MarkerProtocol(name) {
+	case "", MarkerProtocolBlockTerm:
+		return MarkerProtocolBlockTerm, nil
+	case MarkerProtocolOSC133:
+		return MarkerProtocolOSC133, nil
+	default:
+		return "", fmt.Errorf("unknown marker protocol %q", name)
+	}
+}
+
+// newMarkerNonce returns a random per-session token. The shell integration
+// includes it in every marker and the parser ignores markers without it,
+// so program output that merely contains marker text cannot open, close or
+// fake the exit code of a block.
+func newMarkerNonce() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate marker nonce: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// DefaultMarkers returns the default shell markers. The shell integration
+// also adds a "nonce=<token>" field to START and END (see newMarkerNonce).
 func DefaultMarkers() ShellMarkers {
 	return ShellMarkers{
 		CommandStart: "<<<BLOCKTERM:START>>>",
@@ -24,33 +67,91 @@ func DefaultMarkers() ShellMarkers {
 	}
 }
 
+// bashZshMarkerFuncs defines the functions the bash/zsh hooks call to emit
+// block boundaries in the given protocol:
+//
+//	__blockterm_mark_start <command line>
+//	__blockterm_mark_end <exit code>
+//	__blockterm_mark_prompt
+//
+// Every marker carries the session nonce, which is kept in an unexported
+// variable so commands run from the shell cannot read it.
+func bashZshMarkerFuncs(protocol MarkerProtocol, nonce string) string {
+	vars := "__blockterm_nonce='" + nonce + "'\n"
+	if protocol == MarkerProtocolOSC133 {
+		return vars + `# OSC 633;E reports the command line. Backslash, ';' and control
+# characters are escaped as \\ and \xHH so they cannot end the sequence.
+__blockterm_mark_start() {
+  local __bt_cmd="$1" __bt_bs='\'
+  __bt_cmd="${__bt_cmd//"$__bt_bs"/"$__bt_bs$__bt_bs"}"
+  __bt_cmd="${__bt_cmd//;/"${__bt_bs}x3b"}"
+  __bt_cmd="${__bt_cmd//$'\n'/"${__bt_bs}x0a"}"
+  __bt_cmd="${__bt_cmd//$'\r'/"${__bt_bs}x0d"}"
+  __bt_cmd="${__bt_cmd//$'\a'/"${__bt_bs}x07"}"
+  __bt_cmd="${__bt_cmd//$'\e'/"${__bt_bs}x1b"}"
+  printf '\e]633;E;%s;%s\a\e]133;C;nonce=%s\a' "$__bt_cmd" "$__blockterm_nonce" "$__blockterm_nonce"
+}
+__blockterm_mark_end() { printf '\e]133;D;%d;nonce=%s\a' "$1" "$__blockterm_nonce"; }
+__blockterm_mark_prompt() { printf '\e]133;A\a'; }
+`
+	}
+	return vars + `# The command line is base64-encoded so that no byte of it can
+# terminate or forge a marker.
+__blockterm_mark_start() {
+  local __bt_cmd
+  __bt_cmd=$(printf '%s' "$1" | base64 | tr -d '\n')
+  printf '<<<BLOCKTERM:START nonce=%s cmd=%s>>>' "$__blockterm_nonce" "$__bt_cmd"
+}
+__blockterm_mark_end() { printf '<<<BLOCKTERM:END nonce=%s exit=%d>>>' "$__blockterm_nonce" "$1"; }
+__blockterm_mark_prompt() { :; }
+`
+}
+
 // getBashZshInit returns the shell initialization code for bash/zsh.
 // It sources the user's existing rc file first so normal aliases and
 // settings are preserved, then installs the BlockTerm hooks.
-func getBashZshInit() string {
+func getBashZshInit(protocol MarkerProtocol, nonce string) string {
 	return `# BlockTerm shell integration
 export BLOCKTERM_SHELL_INTEGRATION=1
 __blockterm_started=0
 
+` + bashZshMarkerFuncs(protocol, nonce) + `
+
 # precmd – fires after each command, before the prompt.
 # We capture $? immediately so nothing can clobber it.
 __blockterm_precmd() {
   local __bt_exit=$?
   if [[ "$__blockterm_started" == "1" ]]; then
-    printf '<<<BLOCKTERM:END exit=%d>>>' "$__bt_exit"
+    __blockterm_mark_end "$__bt_exit"
     __blockterm_started=0
   fi
+  __blockterm_mark_prompt
   # OSC 7: broadcast current working directory so the UI can track cwd.
-  printf '\e]7;file://%s%s\e\\' "${HOSTNAME:-$(hostname 2>/dev/null)}" "$PWD"
+  printf '\e]7;file://%s%s\e\\' "${HOSTNAME:-$(hostname 2>/dev/null)}" "$(__blockterm_urlencode "$PWD")"
   # Emit active Python environment so the UI can display it.
   # We always emit even when no env is active so the UI can clear the indicator.
   printf '<<<BLOCKTERM:PYENV ve=%s;ce=%s;py=%s>>>' "${VIRTUAL_ENV:-}" "${CONDA_DEFAULT_ENV:-}" "${PYENV_VERSION:-}"
 }
 
+# Percent-encodes $1 byte by byte for the OSC 7 URL, so paths with spaces,
+# '%' or control characters arrive intact.
+__blockterm_urlencode() {
+  local LC_ALL=C __bt_in="$1" __bt_out="" __bt_c __bt_i
+  for (( __bt_i = 0; __bt_i < ${#__bt_in}; __bt_i++ )); do
+    __bt_c="${__bt_in:$__bt_i:1}"
+    case "$__bt_c" in
+      [-/._~a-zA-Z0-9]) __bt_out+="$__bt_c" ;;
+      *) printf -v __bt_c '%%%02X' "'$__bt_c"; __bt_out+="$__bt_c" ;;
+    esac
+  done
+  printf '%s' "$__bt_out"
+}
+
 # preexec – fires just before a command is executed.
+# $1 is the command line; the backend records it to history.
 __blockterm_preexec() {
   __blockterm_started=1
-  printf '<<<BLOCKTERM:START>>>'
+  __blockterm_mark_start "$1"
 }
 
 # For zsh
@@ -66,7 +167,7 @@ if [[ -n "${ZSH_VERSION}" ]]; then
   unsetopt PROMPT_SP
   autoload -Uz add-zsh-hook
   add-zsh-hook precmd  __blockterm_precmd
-  add-zsh-hook preexec __blockterm_preexec
+  add-zsh-hook preexec __blockterm_preexec   # zsh passes the command line as $1
 
 # For bash
 elif [[ -n "${BASH_VERSION}" ]]; then
@@ -74,34 +175,174 @@ elif [[ -n "${BASH_VERSION}" ]]; then
   # are available (mirrors what a regular login shell would do).
   [[ -f ~/.bash_profile ]] && source ~/.bash_profile || [[ -f ~/.profile ]] && source ~/.profile
   [[ -f ~/.bashrc ]] && source ~/.bashrc
-  # DEBUG trap fires before each interactive command.
-  # Guard against re-entry so pipelines only emit one START.
+  # DEBUG trap fires before every simple command, including the ones run
+  # by PROMPT_COMMAND. The trap is armed at the end of PROMPT_COMMAND and
+  # disarmed by the first command after it, so pipelines only emit one
+  # START and an empty command line (which goes straight back to
+  # __blockterm_precmd) emits none.
+  __blockterm_armed=0
+  __blockterm_histnum=""
+
+  # Sets __bt_hist_num/__bt_hist_cmd from the newest history entry.
+  __blockterm_last_history() {
+    local __bt_line
+    __bt_line=$(HISTTIMEFORMAT= builtin history 1)
+    __bt_line="${__bt_line#"${__bt_line%%[![:space:]]*}"}"
+    __bt_hist_num="${__bt_line%%[!0-9]*}"
+    __bt_hist_cmd="${__bt_line#"$__bt_hist_num"}"
+    __bt_hist_cmd="${__bt_hist_cmd#\*}"
+    __bt_hist_cmd="${__bt_hist_cmd#  }"
+  }
+
+  __blockterm_arm() {
+    # History is loaded after the rc files, so take the baseline here.
+    if [[ -z "$__blockterm_histnum" ]]; then
+      local __bt_hist_num __bt_hist_cmd
+      __blockterm_last_history
+      __blockterm_histnum="${__bt_hist_num:-0}"
+    fi
+    __blockterm_armed=1
+  }
+
   __blockterm_debug_handler() {
-    if [[ "$__blockterm_started" == "0" ]]; then
-      __blockterm_preexec
+    [[ "$__blockterm_armed" == "1" ]] || return
+    __blockterm_armed=0
+    [[ "$BASH_COMMAND" == __blockterm_precmd* ]] && return
+    # The full command line is the newest history entry. If the history
+    # number did not advance (HISTCONTROL=ignorespace, history disabled)
+    # fall back to the current simple command.
+    local __bt_hist_num __bt_hist_cmd
+    __blockterm_last_history
+    if [[ -z "$__bt_hist_num" || "$__bt_hist_num" == "$__blockterm_histnum" ]]; then
+      __bt_hist_cmd="$BASH_COMMAND"
     fi
+    __blockterm_histnum="${__bt_hist_num:-$__blockterm_histnum}"
+    __blockterm_preexec "$__bt_hist_cmd"
   }
   trap '__blockterm_debug_handler' DEBUG
-  PROMPT_COMMAND="__blockterm_precmd${PROMPT_COMMAND:+;$PROMPT_COMMAND}"
+  # A newline (not ';') separates __blockterm_arm so that a user
+  # PROMPT_COMMAND ending in ';' stays valid.
+  PROMPT_COMMAND="__blockterm_precmd${PROMPT_COMMAND:+;$PROMPT_COMMAND}"$'\n'"__blockterm_arm"
 fi
 `
 }
 
+// fishMarkerFuncs is the fish counterpart of bashZshMarkerFuncs.
+func fishMarkerFuncs(protocol MarkerProtocol, nonce string) string {
+	vars := "set -g __blockterm_nonce '" + nonce + "'\n"
+	if protocol == MarkerProtocolOSC133 {
+		return vars + `# OSC 633;E reports the command line, escaped like the bash/zsh hook.
+function __blockterm_mark_start
+    set -l c (string replace -a -- '\\' '\\\\' "$argv[1]" | string collect)
+    set c (string replace -a -- ';' '\\x3b' "$c" | string collect)
+    set c (string replace -a -- \n '\\x0a' "$c")
+    set c (string replace -a -- \r '\\x0d' "$c")
+    set c (string replace -a -- \a '\\x07' "$c")
+    set c (string replace -a -- \e '\\x1b' "$c")
+    printf '\e]633;E;%s;%s\a\e]133;C;nonce=%s\a' "$c" $__blockterm_nonce $__blockterm_nonce
+end
+function __blockterm_mark_end
+    printf '\e]133;D;%d;nonce=%s\a' $argv[1] $__blockterm_nonce
+end
+function __blockterm_mark_prompt
+    printf '\e]133;A\a'
+end
+`
+	}
+	return vars + `# The command line is base64-encoded like the bash/zsh hook.
+function __blockterm_mark_start
+    printf '<<<BLOCKTERM:START nonce=%s cmd=%s>>>' $__blockterm_nonce (printf '%s' $argv[1] | base64 | tr -d '\n')
+end
+function __blockterm_mark_end
+    printf '<<<BLOCKTERM:END nonce=%s exit=%d>>>' $__blockterm_nonce $argv[1]
+end
+function __blockterm_mark_prompt
+end
+`
+}
+
+// getFishInit returns the shell initialization code for fish.
+// It is sourced via --init-command, which fish runs after config.fish, so
+// the user's configuration is already in place. Hooks use fish's own
+// events instead of wrapping functions, so custom prompts keep working.
+func getFishInit(protocol MarkerProtocol, nonce string) string {
+	return `# BlockTerm shell integration for fish
+set -gx BLOCKTERM_SHELL_INTEGRATION 1
+set -g __blockterm_started 0
+
+` + fishMarkerFuncs(protocol, nonce) + `
+# fish_preexec fires before a command line runs (not for empty lines);
+# $argv[1] is the command line.
+function __blockterm_preexec --on-event fish_preexec
+    set -g __blockterm_started 1
+    __blockterm_mark_start $argv[1]
+end
+
+# fish_postexec fires after the command; $status is still its exit code.
+function __blockterm_postexec --on-event fish_postexec
+    set -l __bt_exit $status
+    if test "$__blockterm_started" = 1
+        __blockterm_mark_end $__bt_exit
+        set -g __blockterm_started 0
+    end
+end
+
+# fish_prompt fires before every prompt is drawn.
+function __blockterm_prompt --on-event fish_prompt
+    __blockterm_mark_prompt
+    # OSC 7: broadcast current working directory so the UI can track cwd.
+    printf '\e]7;file://%s%s\a' $hostname (string escape --style=url -- $PWD)
+    # Emit active Python environment so the UI can display it.
+    printf '<<<BLOCKTERM:PYENV ve=%s;ce=%s;py=%s>>>' "$VIRTUAL_ENV" "$CONDA_DEFAULT_ENV" "$PYENV_VERSION"
+end
+`
+}
+
+// powerShellMarkerFuncs is the PowerShell counterpart of bashZshMarkerFuncs.
+// PowerShell cannot report the command line, so START carries none.
+func powerShellMarkerFuncs(protocol MarkerProtocol, nonce string) string {
+	vars := "$__bt_nonce = '" + nonce + "'\n\n"
+	if protocol == MarkerProtocolOSC133 {
+		return vars + `function __BlockTerm-MarkStart {
+    [System.Console]::Out.Write("$([char]27)]133;C;nonce=$__bt_nonce$([char]7)")
+}
+
+function __BlockTerm-MarkEnd {
+    param([int]$ExitCode)
+    [System.Console]::Out.Write("$([char]27)]133;D;$ExitCode;nonce=$__bt_nonce$([char]7)$([char]27)]133;A$([char]7)")
+}
+`
+	}
+	return vars + `function __BlockTerm-MarkStart {
+    [System.Console]::Out.Write("<<<BLOCKTERM:START nonce=$__bt_nonce>>>")
+}
+
+function __BlockTerm-MarkEnd {
+    param([int]$ExitCode)
+    [System.Console]::Out.Write("<<<BLOCKTERM:END nonce=$__bt_nonce exit=$ExitCode>>>")
+}
+`
+}
+
 // getPowerShellInit returns the PowerShell profile initialization code.
-func getPowerShellInit() string {
+func getPowerShellInit(protocol MarkerProtocol, nonce string) string {
 	// PowerShell uses backtick as its escape character (`e = ESC), which would
 	// terminate Go's raw-string literal. Embed ESC directly with \x1b instead.
-	const psOsc7 = "    [System.Console]::Out.Write(\"\x1b]7;file://$env:COMPUTERNAME$($PWD.Path.Replace('\\','/'))\x1b\\\")\n"
+	// Each path segment is percent-encoded, and Windows paths get a leading
+	// slash: file://HOST/C:/Users.
+	const psOsc7 = "    $__bt_cwd = (($PWD.Path.Replace('\\','/').TrimStart('/') -split '/') | ForEach-Object { [Uri]::EscapeDataString($_) }) -join '/'\n" +
+		"    [System.Console]::Out.Write(\"\x1b]7;file://$env:COMPUTERNAME/$__bt_cwd\x1b\\\")\n"
 	return `# BlockTerm shell integration for PowerShell
 $env:BLOCKTERM_SHELL_INTEGRATION = "1"
 
+` + powerShellMarkerFuncs(protocol, nonce) + `
 function __BlockTerm-PreExec {
-    [System.Console]::Out.Write("<<<BLOCKTERM:START>>>")
+    __BlockTerm-MarkStart
 }
 
 function __BlockTerm-PreCmd {
     param([int]$ExitCode)
-    [System.Console]::Out.Write("<<<BLOCKTERM:END exit=$ExitCode>>>")
+    __BlockTerm-MarkEnd -ExitCode $ExitCode
 }
 
 # Preserve the original prompt
@@ -131,21 +372,30 @@ $ExecutionContext.InvokeCommand.PreCommandLookupAction = {
 
 // getInitializationScript returns the shell initialization code for the
 // given shell *name* (not full path – use filepath.Base before calling).
-func getInitializationScript(shellName string) string {
+func getInitializationScript(shellName string, protocol MarkerProtocol, nonce string) string {
 	switch shellName {
 	case "bash", "zsh":
-		return getBashZshInit()
+		return getBashZshInit(protocol, nonce)
+	case "fish":
+		return getFishInit(protocol, nonce)
 	case "pwsh", "powershell":
-		return getPowerShellInit()
+		return getPowerShellInit(protocol, nonce)
 	default:
 		return ""
 	}
 }
 
+// defaultTerm is the TERM a session gets unless it asks for another.
+const defaultTerm = "xterm-256color"
+
 // getEnvSetup returns environment variables needed for shell integration.
-func getEnvSetup() []string {
+// term overrides the default TERM.
+func getEnvSetup(term string) []string {
+	if term == "" {
+		term = defaultTerm
+	}
 	return []string{
-		"TERM=xterm-256color",
+		"TERM=" + term,
 	}
 }
 
@@ -154,41 +404,50 @@ func FormatCommandEndMarker(exitCode int) string {
 	return fmt.Sprintf(DefaultMarkers().CommandEnd, exitCode)
 }
 
-// createInitFile creates a temporary initialization file with the shell
-// integration script. Returns the file path and a cleanup function.
+// createInitFile writes the shell integration script to a file in a new
+// private temp directory. The script deletes the file as its first command,
+// so the marker nonce is not left on disk once the shell has read it. The
+// returned cleanup removes the directory, and with it the file if the shell
+// never got that far.
+//
+// For zsh the file is the directory's .zshrc, so the directory can be used
+// as ZDOTDIR.
 func createInitFile(shellName, initScript string) (string, func(), error) {
 	if initScript == "" {
 		return "", nil, nil
 	}
 
-	var tempFile *os.File
-	var err error
+	dir, err := os.MkdirTemp("", "blockterm-init-*")
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to create init dir: %w", err)
+	}
+	cleanup := func() { os.RemoveAll(dir) }
 
+	var name, remove string
 	switch shellName {
-	case "bash":
-		tempFile, err = os.CreateTemp("", "blockterm-bash-init-*.sh")
 	case "zsh":
-		tempFile, err = os.CreateTemp("", "blockterm-zsh-init-*.zsh")
+		name = ".zshrc"
+	case "fish":
+		name = "init.fish"
 	case "pwsh", "powershell":
-		tempFile, err = os.CreateTemp("", "blockterm-ps-init-*.ps1")
+		name = "init.ps1"
 	default:
-		tempFile, err = os.CreateTemp("", "blockterm-shell-init-*.sh")
+		name = "init.sh"
 	}
-
-	if err != nil {
-		return "", nil, fmt.Errorf("failed to create temp init file: %w", err)
+	filePath := filepath.Join(dir, name)
+	switch shellName {
+	case "pwsh", "powershell":
+		remove = fmt.Sprintf("Remove-Item -LiteralPath '%s' -Force -ErrorAction SilentlyContinue\n", filePath)
+	default:
+		remove = fmt.Sprintf("command rm -f -- '%s'\n", filePath)
 	}
 
-	if _, err := tempFile.WriteString(initScript); err != nil {
-		tempFile.Close()
-		os.Remove(tempFile.Name())
+	if err := os.WriteFile(filePath, []byte(remove+initScript), 0o600); err != nil {
+		cleanup()
 		return "", nil, fmt.Errorf("failed to write init script: %w", err)
 	}
 
-	filePath := tempFile.Name()
-	tempFile.Close()
-
-	return filePath, func() { os.Remove(filePath) }, nil
+	return filePath, cleanup, nil
 }
 
 // prepareShellCommand prepares the shell command with the BlockTerm
@@ -196,12 +455,43 @@ func createInitFile(shellName, initScript string) (string, func(), error) {
 // cleanup function (may be nil if no temp file was created).
 //
 // shellPath may be a full path (e.g. /bin/zsh); the shell name is derived
-// via filepath.Base so comparisons always work.
-func prepareShellCommand(shellPath string) (string, []string, func(), error) {
+// via filepath.Base so comparisons always work. login starts a login shell
+// and extra is passed to the shell after the integration's own arguments.
+//
+// If command is set, the shell runs it once its startup files are loaded
+// and exits, instead of prompting; it still starts as an interactive shell
+// so the user's aliases and functions are defined.
+func prepareShellCommand(shellPath string, protocol MarkerProtocol, nonce string, login bool, extra []string, command string) (string, []string, func(), error) {
 	shellName := filepath.Base(shellPath)
-	initScript := getInitializationScript(shellName)
+	initScript := getInitializationScript(shellName, protocol, nonce)
+
+	// Arguments that make a POSIX-style shell run command.
+	var runArgs []string
+	if command != "" {
+		runArgs = []string{"-c", command}
+	}
+
 	if initScript == "" {
-		return shellPath, nil, nil, nil
+		var args []string
+		if login {
+			args = append(args, "-l")
+		}
+		args = append(args, extra...)
+		switch {
+		case command == "":
+		case strings.EqualFold(shellName, "cmd.exe"), strings.EqualFold(shellName, "cmd"):
+			args = append(args, "/C", command)
+		default:
+			args = append(args, "-i")
+			args = append(args, runArgs...)
+		}
+		return shellPath, args, nil, nil
+	}
+	if login && shellName == "bash" {
+		// bash ignores --rcfile in a login shell, so the rc file does the
+		// system-wide part of a login itself; it already reads the user's
+		// profile.
+		initScript = "[[ -f /etc/profile ]] && source /etc/profile\n" + initScript
 	}
 
 	initFile, cleanup, err := createInitFile(shellName, initScript)
@@ -214,28 +504,40 @@ func prepareShellCommand(shellPath string) (string, []string, func(), error) {
 		switch shellName {
 		case "bash":
 			// --rcfile replaces ~/.bashrc; our init sources ~/.bashrc itself.
-			args = []string{"--rcfile", initFile}
+			args = append([]string{"--rcfile", initFile}, extra...)
+			if command != "" {
+				// --rcfile is only read by interactive shells, which print
+				// "exit" when the exit builtin ends them; a subshell does
+				// not.
+				args = append(args, "-i", "-c", "(\n"+command+"\n)")
+			}
 		case "zsh":
-			// Point ZDOTDIR at a temp dir whose .zshrc is our init file.
-			// We rename the temp file to .zshrc inside a dedicated directory.
-			zdotdir, zdotCleanup, zdotErr := createZshZdotdir(initFile)
-			if zdotErr == nil && zdotdir != "" {
-				// Wrap cleanup to remove both the init file and the zdotdir.
-				origCleanup := cleanup
-				cleanup = func() {
-					origCleanup()
-					zdotCleanup()
-				}
-				// Pass ZDOTDIR via env; args stay empty (zsh picks up .zshrc).
-				args = []string{"-d", "-f", "--no-globalrcs",
-					"-c", fmt.Sprintf("ZDOTDIR=%s exec zsh -i", zdotdir)}
-				break
+			// Point ZDOTDIR at the init file's directory, whose .zshrc it
+			// is. The real shell is started by -c, so it gets login and
+			// extra.
+			flags := zshFlags(login, append(slices.Clip(extra), runArgs...))
+			args = []string{"-d", "-f", "--no-globalrcs",
+				"-c", fmt.Sprintf("ZDOTDIR=%s exec zsh %s", filepath.Dir(initFile), flags)}
+		case "fish":
+			if login {
+				args = append(args, "--login")
 			}
-			// Fallback: source init then exec interactive zsh.
-			args = []string{"-c", fmt.Sprintf("source %s; exec zsh -i", initFile)}
+			// --init-command runs after config.fish, keeping the user's setup.
+			args = append(args, "--interactive", "--init-command", fmt.Sprintf("source '%s'", initFile))
+			args = append(args, extra...)
+			args = append(args, runArgs...)
 		case "pwsh", "powershell":
-			args = []string{"-NoProfile", "-Command",
-				fmt.Sprintf(". '%s'; $host.EnterNestedPrompt()", initFile)}
+			// -Login must come first, and everything after -Command is
+			// part of the command.
+			if login {
+				args = append(args, "-Login")
+			}
+			args = append(args, extra...)
+			then := "$host.EnterNestedPrompt()"
+			if command != "" {
+				then = command
+			}
+			args = append(args, "-NoProfile", "-Command", fmt.Sprintf(". '%s'; %s", initFile, then))
 		default:
 			args = []string{"-c", fmt.Sprintf(". %s; exec %s -i", initFile, shellPath)}
 		}
@@ -244,25 +546,15 @@ func prepareShellCommand(shellPath string) (string, []string, func(), error) {
 	return shellPath, args, cleanup, nil
 }
 
-// createZshZdotdir creates a temporary directory to serve as ZDOTDIR for zsh.
-// It places the provided initFile content as .zshrc inside that directory.
-func createZshZdotdir(initFile string) (string, func(), error) {
-	dir, err := os.MkdirTemp("", "blockterm-zdotdir-*")
-	if err != nil {
-		return "", nil, fmt.Errorf("failed to create zdotdir: %w", err)
+// zshFlags returns the arguments of the interactive zsh that the zsh
+// integration execs, quoted for the -c script that starts it.
+func zshFlags(login bool, extra []string) string {
+	flags := "-i"
+	if login {
+		flags += "l"
 	}
-
-	// Read existing init script
-	content, err := os.ReadFile(initFile)
-	if err != nil {
-		os.RemoveAll(dir)
-		return "", nil, fmt.Errorf("failed to read init file: %w", err)
-	}
-
-	if err := os.WriteFile(filepath.Join(dir, ".zshrc"), content, 0o600); err != nil {
-		os.RemoveAll(dir)
-		return "", nil, fmt.Errorf("failed to write .zshrc: %w", err)
+	for _, arg := range extra {
+		flags += " '" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
 	}
-
-	return dir, func() { os.RemoveAll(dir) }, nil
+	return flags
 }
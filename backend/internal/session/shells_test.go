@@ -0,0 +1,171 @@
+package session
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPrepareShellCommandFish(t *testing.T) {
+	shell, args, cleanup, err := prepareShellCommand("/usr/bin/fish", MarkerProtocolBlockTerm, "nonce", false, nil, "")
+	if err != nil {
+		t.Fatalf("prepareShellCommand: %v", err)
+	}
+	defer cleanup()
+
+	if shell != "/usr/bin/fish" {
+		t.Errorf("shell = %q, want /usr/bin/fish", shell)
+	}
+	if len(args) != 3 || args[0] != "--interactive" || args[1] != "--init-command" {
+		t.Fatalf("args = %q, want --interactive --init-command <source>", args)
+	}
+	for _, arg := range args {
+		if arg == "-c" {
+			t.Fatalf("fish must not be launched via -c: %q", args)
+		}
+	}
+
+	initFile := strings.TrimSuffix(strings.TrimPrefix(args[2], "source '"), "'")
+	script, err := os.ReadFile(initFile)
+	if err != nil {
+		t.Fatalf("read init file: %v", err)
+	}
+	for _, want := range []string{"--on-event fish_preexec", "--on-event fish_postexec", "--on-event fish_prompt"} {
+		if !strings.Contains(string(script), want) {
+			t.Errorf("init script missing %q", want)
+		}
+	}
+}
+
+// TestFishSessionBlocks runs a real fish in a PTY and checks that commands
+// produce blocks with the command line, exit code and cwd.
+func TestFishSessionBlocks(t *testing.T) {
+	fish, err := exec.LookPath("fish")
+	if err != nil {
+		t.Skip("fish not installed")
+	}
+
+	// A throwaway config.fish proves the user's configuration still loads.
+	home := t.TempDir()
+	configDir := filepath.Join(home, ".config", "fish")
+	if err := os.MkdirAll(configDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	config := "set -gx BLOCKTERM_TEST_CONFIG loaded\n"
+	if err := os.WriteFile(filepath.Join(configDir, "config.fish"), []byte(config), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	workDir := t.TempDir()
+
+	m := NewManager()
+	defer m.Close()
+
+	sess, err := m.StartSession(SessionOptions{
+		Shell: fish,
+		Cwd:   workDir,
+		Cols:  120,
+		Rows:  40,
+		Env:   []string{"HOME=" + home, "XDG_CONFIG_HOME=" + filepath.Join(home, ".config")},
+	})
+	if err != nil {
+		t.Fatalf("StartSession: %v", err)
+	}
+	sub, _, err := m.Subscribe(sess.ID, Position{}, OverflowDropGap)
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+	defer m.Unsubscribe(sess.ID, sub)
+
+	run := func(line string) (start, end OutputChunk, output string) {
+		t.Helper()
+		if err := m.WriteInput(sess.ID, []byte(line+"\n")); err != nil {
+			t.Fatalf("WriteInput: %v", err)
+		}
+		var out strings.Builder
+		timeout := time.After(10 * time.Second)
+		for {
+			select {
+			case chunk := <-sub.C:
+				switch {
+				case chunk.Status == CommandRunning && len(chunk.Data) == 0:
+					start = chunk
+				case start.CommandID != "" && chunk.CommandID == start.CommandID && len(chunk.Data) > 0:
+					out.Write(chunk.Data)
+				case start.CommandID != "" && chunk.CommandID == start.CommandID:
+					return start, chunk, out.String()
+				}
+			case <-timeout:
+				t.Fatalf("timed out waiting for block of %q", line)
+			}
+		}
+	}
+
+	start, end, output := run("echo $BLOCKTERM_TEST_CONFIG")
+	if start.Command != "echo $BLOCKTERM_TEST_CONFIG" {
+		t.Errorf("command = %q", start.Command)
+	}
+	if end.Status != CommandCompleted || end.ExitCode != 0 {
+		t.Errorf("end = %s/%d, want completed/0", end.Status, end.ExitCode)
+	}
+	if !strings.Contains(output, "loaded") {
+		t.Errorf("config.fish not loaded; output = %q", output)
+	}
+
+	_, end, _ = run("false")
+	if end.Status != CommandFailed || end.ExitCode != 1 {
+		t.Errorf("end = %s/%d, want failed/1", end.Status, end.ExitCode)
+	}
+
+	sub2 := filepath.Join(workDir, "sub")
+	if err := os.Mkdir(sub2, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	run("cd sub")
+	deadline := time.Now().Add(5 * time.Second)
+	for sess.CurrentCwd() != sub2 && time.Now().Before(deadline) {
+		time.Sleep(20 * time.Millisecond)
+	}
+	if got := sess.CurrentCwd(); got != sub2 {
+		t.Errorf("cwd = %q, want %q", got, sub2)
+	}
+}
+
+// TestInitFileRemovesItself checks that the init file, which holds the
+// marker nonce, is private and gone once bash has read it.
+func TestInitFileRemovesItself(t *testing.T) {
+	bash, err := exec.LookPath("bash")
+	if err != nil {
+		t.Skip("bash not installed")
+	}
+	t.Setenv("HOME", t.TempDir())
+
+	shell, args, cleanup, err := prepareShellCommand(bash, MarkerProtocolBlockTerm, "nonce", false, nil, "true")
+	if err != nil {
+		t.Fatalf("prepareShellCommand: %v", err)
+	}
+	defer cleanup()
+	if len(args) < 2 || args[0] != "--rcfile" {
+		t.Fatalf("args = %q, want --rcfile <init file> ...", args)
+	}
+	initFile := args[1]
+
+	for path, want := range map[string]os.FileMode{filepath.Dir(initFile): 0o700, initFile: 0o600} {
+		info, err := os.Stat(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if perm := info.Mode().Perm(); perm != want {
+			t.Errorf("%s mode = %o, want %o", path, perm, want)
+		}
+	}
+
+	if out, err := exec.Command(shell, args...).CombinedOutput(); err != nil {
+		t.Fatalf("bash: %v\n%s", err, out)
+	}
+	if _, err := os.Stat(initFile); !os.IsNotExist(err) {
+		t.Errorf("init file after bash started: %v, want it removed", err)
+	}
+}
@@ -0,0 +1,37 @@
+package session
+
+import (
+	"fmt"
+	"os"
+)
+
+// SignalSession delivers sig to the foreground process group of a session's
+// PTY, i.e. whatever job currently owns the terminal (the shell itself when
+// at a prompt). Unlike writing ^C to the PTY this also works for programs
+// that put the terminal in raw mode. It returns the process group and the
+// PIDs that were in it when the signal was sent.
+func (m *Manager) SignalSession(sessionID string, sig os.Signal) (int, []int, error) {
+	session, err := m.GetSession(sessionID)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	session.mu.RLock()
+	ptmx := session.PTY
+	session.mu.RUnlock()
+	if ptmx == nil {
+		return 0, nil, fmt.Errorf("session %s has no terminal", sessionID)
+	}
+
+	pgid, err := foregroundProcessGroup(ptmx)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to resolve foreground process group: %w", err)
+	}
+
+	// Collect members first; SIGKILL would leave nothing to report.
+	pids := processGroupMembers(pgid)
+	if err := signalProcessGroup(pgid, sig); err != nil {
+		return pgid, nil, fmt.Errorf("failed to signal process group %d: %w", pgid, err)
+	}
+	return pgid, pids, nil
+}
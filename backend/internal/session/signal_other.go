@@ -0,0 +1,29 @@
+//go:build !unix
+
+package session
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"syscall"
+)
+
+var errSignalsUnsupported = errors.New("process group signals are not supported on this platform")
+
+// ParseSignal always fails: there are no process groups to signal.
+func ParseSignal(name string) (os.Signal, error) {
+	return nil, fmt.Errorf("unsupported signal %q: %w", name, errSignalsUnsupported)
+}
+
+func foregroundProcessGroup(*os.File) (int, error) {
+	return 0, errSignalsUnsupported
+}
+
+func signalProcessGroup(int, os.Signal) error {
+	return errSignalsUnsupported
+}
+
+func signalName(sig syscall.Signal) string {
+	return sig.String()
+}
@@ -0,0 +1,57 @@
+//go:build unix
+
+package session
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+// sessionSignals are the signals SignalSession accepts, by name.
+var sessionSignals = map[string]syscall.Signal{
+	"INT":  syscall.SIGINT,
+	"TERM": syscall.SIGTERM,
+	"KILL": syscall.SIGKILL,
+	"TSTP": syscall.SIGTSTP,
+	"CONT": syscall.SIGCONT,
+	"HUP":  syscall.SIGHUP,
+	"QUIT": syscall.SIGQUIT,
+}
+
+// ParseSignal resolves a signal name such as "INT" or "SIGINT"
+// (case-insensitive) to one of the signals SignalSession may send.
+func ParseSignal(name string) (os.Signal, error) {
+	sig, ok := sessionSignals[strings.TrimPrefix(strings.ToUpper(name), "SIG")]
+	if !ok {
+		return nil, fmt.Errorf("unsupported signal %q", name)
+	}
+	return sig, nil
+}
+
+// foregroundProcessGroup returns the process group that owns the terminal
+// (tcgetpgrp). The ioctl is issued on the master side, which reports the
+// foreground group of the slave.
+func foregroundProcessGroup(ptmx *os.File) (int, error) {
+	return unix.IoctlGetInt(int(ptmx.Fd()), unix.TIOCGPGRP)
+}
+
+// signalProcessGroup sends sig to every process in the group pgid.
+func signalProcessGroup(pgid int, sig os.Signal) error {
+	s, ok := sig.(syscall.Signal)
+	if !ok {
+		return fmt.Errorf("unsupported signal %v", sig)
+	}
+	return syscall.Kill(-pgid, s)
+}
+
+// signalName returns the conventional name of sig, such as "SIGINT".
+func signalName(sig syscall.Signal) string {
+	if name := unix.SignalName(sig); name != "" {
+		return name
+	}
+	return sig.String()
+}
@@ -0,0 +1,162 @@
+package session
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+// newTestSession registers a running session whose "PTY" is the read end of
+// a pipe, so tests can drive readOutput without spawning a shell.
+func newTestSession(t *testing.T, m *Manager) (*Session, *os.File) {
+	t.Helper()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { r.Close(); w.Close() })
+
+	session := &Session{
+		ID:         "test",
+		PTY:        r,
+		State:      StateRunning,
+		CreatedAt:  time.Now(),
+		parser:     newBlockParser(""),
+		scrollback: newScrollback(0),
+		outputDone: make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+	m.mu.Lock()
+	m.sessions[session.ID] = session
+	m.mu.Unlock()
+	return session, w
+}
+
+// TestSlowSubscriberDoesNotBlockOthers checks that a subscriber which never
+// reads neither stalls the PTY read loop nor delays another subscriber, and
+// that it learns how much it missed once it catches up.
+func TestSlowSubscriberDoesNotBlockOthers(t *testing.T) {
+	m := NewManager()
+	session, pty := newTestSession(t, m)
+
+	slow, _, err := m.Subscribe(session.ID, Position{}, OverflowDropGap)
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+	defer m.Unsubscribe(session.ID, slow)
+	fast, _, err := m.Subscribe(session.ID, Position{}, OverflowDropGap)
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+	defer m.Unsubscribe(session.ID, fast)
+
+	go m.readOutput(session)
+
+	// Far more chunks than fit in a queue, written one read at a time.
+	const writes = subscriberQueueLen * 3
+	line := []byte("0123456789abcdef\n")
+	go func() {
+		for i := 0; i < writes; i++ {
+			pty.Write(line)
+			time.Sleep(10 * time.Microsecond)
+		}
+	}()
+
+	var got int
+	timeout := time.After(10 * time.Second)
+	for got < writes*len(line) {
+		select {
+		case chunk := <-fast.C:
+			if chunk.Dropped != 0 {
+				t.Fatalf("fast subscriber dropped %d chunks", chunk.Dropped)
+			}
+			got += len(chunk.Data)
+		case <-timeout:
+			t.Fatalf("fast subscriber received %d of %d bytes", got, writes*len(line))
+		}
+	}
+
+	// The slow subscriber holds a full queue; draining it and producing
+	// more output surfaces the gap.
+	var last OutputChunk
+	for len(slow.C) > 0 {
+		last = <-slow.C
+	}
+	pty.Write([]byte("after\n"))
+	select {
+	case chunk := <-slow.C:
+		if chunk.Dropped == 0 {
+			t.Fatal("chunk after overflow does not report dropped chunks")
+		}
+		if gap := chunk.Offset - (last.Offset + int64(len(last.Data))); gap <= 0 {
+			t.Errorf("offset gap = %d, want > 0", gap)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("slow subscriber received nothing after draining")
+	}
+	if err := slow.Err(); err != nil {
+		t.Errorf("Err() = %v, want nil", err)
+	}
+}
+
+// TestSlowSubscriberDisconnect checks that OverflowDisconnect ends a
+// subscription that falls behind and removes it from the session.
+func TestSlowSubscriberDisconnect(t *testing.T) {
+	m := NewManager()
+	session, _ := newTestSession(t, m)
+
+	sub, _, err := m.Subscribe(session.ID, Position{}, OverflowDisconnect)
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+	defer m.Unsubscribe(session.ID, sub)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i <= subscriberQueueLen; i++ {
+			m.publish(session, []OutputChunk{{Data: []byte("x")}})
+		}
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("publish blocked on a slow subscriber")
+	}
+
+	select {
+	case <-sub.Done():
+	default:
+		t.Fatal("subscription still open after overflowing")
+	}
+	if err := sub.Err(); err != ErrSlowConsumer {
+		t.Errorf("Err() = %v, want ErrSlowConsumer", err)
+	}
+	if info := session.Info(); info.AttachedClients != 0 {
+		t.Errorf("AttachedClients = %d, want 0", info.AttachedClients)
+	}
+}
+
+// TestUnsubscribeRemovesSubscriber checks that a closed stream no longer
+// counts as attached.
+func TestUnsubscribeRemovesSubscriber(t *testing.T) {
+	m := NewManager()
+	session, _ := newTestSession(t, m)
+
+	sub, _, err := m.Subscribe(session.ID, Position{}, OverflowDropGap)
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+	m.Unsubscribe(session.ID, sub)
+
+	if info := session.Info(); info.AttachedClients != 0 {
+		t.Errorf("AttachedClients = %d, want 0", info.AttachedClients)
+	}
+	if session.Info().DetachedAt.IsZero() {
+		t.Error("DetachedAt not set after the last subscriber left")
+	}
+	m.publish(session, []OutputChunk{{Data: []byte("x")}})
+	if len(sub.C) != 0 {
+		t.Error("output delivered to an unsubscribed subscription")
+	}
+}
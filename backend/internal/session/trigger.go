@@ -0,0 +1,311 @@
+package session
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/entl/blockterm/internal/storage"
+)
+
+// Trigger scopes: which sessions a trigger watches.
+const (
+	TriggerScopeAll     = "all"     // every session
+	TriggerScopeProfile = "profile" // sessions started from the profile ScopeID
+	TriggerScopeSession = "session" // the session ScopeID
+)
+
+// Trigger actions: what happens when a trigger's pattern matches. Every
+// match is also reported on the session's output stream.
+const (
+	ActionEvent     = "event"      // notify WatchEvents callers, even if nobody streams the session
+	ActionHighlight = "highlight"  // mark the matched span; Argument names a style for clients
+	ActionSendInput = "send_input" // type Argument into the session; scope "session" only
+	ActionFailBlock = "fail_block" // report the current command block as failed when it ends
+	ActionBell      = "bell"       // ask clients to ring the bell
+)
+
+// EventTrigger is the SessionEvent kind for ActionEvent triggers.
+const EventTrigger = "trigger"
+
+// maxTriggerLine bounds the part of an unfinished output line triggers are
+// matched against; longer lines are matched on their tail.
+const maxTriggerLine = 4096
+
+// triggerInputCooldown is how soon a send_input trigger may fire again in
+// the same session, so input echoed back cannot set it off in a loop.
+const triggerInputCooldown = time.Second
+
+// Trigger is a compiled output trigger.
+type Trigger struct {
+	ID       string
+	Name     string
+	Pattern  *regexp.Regexp
+	Scope    string
+	ScopeID  string
+	Action   string
+	Argument string
+
+	// Access is what the trigger may do to its session, for triggers that
+	// NeedAccess; their input is written with it. Without it they do
+	// nothing.
+	Access *Access
+}
+
+// NeedsAccess reports whether the trigger acts on its session for whoever
+// created it, and so needs the session's owner token: send_input, and
+// fail_block scoped to a session.
+func (t Trigger) NeedsAccess() bool {
+	return t.Action == ActionSendInput || t.Scope == TriggerScopeSession && t.Action == ActionFailBlock
+}
+
+// TriggerHit reports one match of a trigger in a session's output.
+type TriggerHit struct {
+	TriggerID string
+	Name      string
+	Action    string
+	Argument  string
+	Offset    int64  // output offset of the matched text
+	Text      string // the matched text
+	CommandID string // block the output belongs to, if any
+
+	access *Access // the trigger's Access
+}
+
+// triggerState is what a session tracks to match triggers line by line.
+type triggerState struct {
+	line        []byte          // output since the last newline
+	lineStart   int64           // output offset of line[0]
+	fired       map[string]bool // triggers that matched the current line
+	lastInput   map[string]time.Time
+	failBlock   string // command block a fail_block trigger matched in
+	failBlockBy string // the trigger that matched
+}
+
+// CompileTrigger validates a stored trigger and compiles its pattern.
+func CompileTrigger(t *storage.Trigger) (Trigger, error) {
+	if t.Pattern == "" {
+		return Trigger{}, fmt.Errorf("pattern is required")
+	}
+	re, err := regexp.Compile(t.Pattern)
+	if err != nil {
+		return Trigger{}, fmt.Errorf("invalid pattern: %w", err)
+	}
+
+	switch t.Scope {
+	case TriggerScopeAll:
+		if t.ScopeID != "" {
+			return Trigger{}, fmt.Errorf("scope_id must be empty for scope %q", t.Scope)
+		}
+	case TriggerScopeProfile, TriggerScopeSession:
+		if t.ScopeID == "" {
+			return Trigger{}, fmt.Errorf("scope %q requires scope_id", t.Scope)
+		}
+	default:
+		return Trigger{}, fmt.Errorf("unknown scope %q", t.Scope)
+	}
+
+	switch t.Action {
+	case ActionEvent, ActionHighlight, ActionFailBlock, ActionBell:
+	case ActionSendInput:
+		if t.Argument == "" {
+			return Trigger{}, fmt.Errorf("action %q requires the input as argument", t.Action)
+		}
+		if t.Scope != TriggerScopeSession {
+			// Nobody may type into every session, or into ones not yet
+			// started.
+			return Trigger{}, fmt.Errorf("action %q requires scope %q", t.Action, TriggerScopeSession)
+		}
+	default:
+		return Trigger{}, fmt.Errorf("unknown action %q", t.Action)
+	}
+
+	return Trigger{
+		ID:       t.ID,
+		Name:     t.Name,
+		Pattern:  re,
+		Scope:    t.Scope,
+		ScopeID:  t.ScopeID,
+		Action:   t.Action,
+		Argument: t.Argument,
+	}, nil
+}
+
+// SetTriggers replaces the triggers evaluated on every session's output.
+func (m *Manager) SetTriggers(triggers []Trigger) {
+	m.mu.Lock()
+	m.triggers = triggers
+	m.mu.Unlock()
+}
+
+// sessionTriggers returns the triggers that watch a session. Triggers that
+// need access but were given none are left out.
+func (m *Manager) sessionTriggers(session *Session) []Trigger {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var triggers []Trigger
+	for _, t := range m.triggers {
+		if t.NeedsAccess() && t.Access == nil {
+			continue
+		}
+		switch {
+		case t.Scope == TriggerScopeAll,
+			t.Scope == TriggerScopeSession && t.ScopeID == session.ID,
+			t.Scope == TriggerScopeProfile && t.ScopeID == session.ProfileID:
+			triggers = append(triggers, t)
+		}
+	}
+	return triggers
+}
+
+// matchTriggers checks a published data chunk against the session's
+// triggers. Patterns are matched against each output line without its line
+// ending, escape sequences included; a carriage return starts the line over,
+// as it does on screen. A trigger fires at most once per line, as soon as
+// the part of the line received so far matches.
+func (m *Manager) matchTriggers(session *Session, chunk OutputChunk) []TriggerHit {
+	triggers := m.sessionTriggers(session)
+	if len(triggers) == 0 {
+		return nil
+	}
+
+	session.triggerMu.Lock()
+	defer session.triggerMu.Unlock()
+
+	st := &session.triggers
+	if st.fired == nil {
+		st.fired = make(map[string]bool)
+	}
+	if chunk.Offset != st.lineStart+int64(len(st.line)) {
+		// Not contiguous with what was seen; start a new line.
+		st.line = st.line[:0]
+		st.lineStart = chunk.Offset
+		clear(st.fired)
+	}
+
+	var hits []TriggerHit
+	data := chunk.Data
+	for len(data) > 0 {
+		segment := data
+		newline := bytes.IndexByte(data, '\n')
+		if newline >= 0 {
+			segment = data[:newline+1]
+		}
+		data = data[len(segment):]
+
+		st.line = append(st.line, segment...)
+		if len(st.line) > maxTriggerLine {
+			cut := len(st.line) - maxTriggerLine
+			st.line = append(st.line[:0], st.line[cut:]...)
+			st.lineStart += int64(cut)
+		}
+
+		text := bytes.TrimRight(st.line, "\r\n")
+		start := bytes.LastIndexByte(text, '\r') + 1
+		text = text[start:]
+		for _, t := range triggers {
+			if st.fired[t.ID] {
+				continue
+			}
+			loc := t.Pattern.FindIndex(text)
+			if loc == nil {
+				continue
+			}
+			st.fired[t.ID] = true
+			hits = append(hits, TriggerHit{
+				TriggerID: t.ID,
+				Name:      t.Name,
+				Action:    t.Action,
+				Argument:  t.Argument,
+				Offset:    st.lineStart + int64(start+loc[0]),
+				Text:      string(text[loc[0]:loc[1]]),
+				CommandID: chunk.CommandID,
+				access:    t.Access,
+			})
+		}
+
+		if newline >= 0 {
+			st.lineStart += int64(len(st.line))
+			st.line = st.line[:0]
+			clear(st.fired)
+		}
+	}
+	return hits
+}
+
+// fireTriggers reports trigger hits on the session's output stream and
+// takes their actions.
+func (m *Manager) fireTriggers(session *Session, hits []TriggerHit) {
+	chunks := make([]OutputChunk, len(hits))
+	for i := range hits {
+		chunks[i] = OutputChunk{CommandID: hits[i].CommandID, Trigger: &hits[i]}
+	}
+	m.publish(session, chunks)
+
+	for _, hit := range hits {
+		log.Printf("session %s: trigger %s (%s) matched %q", session.ID, hit.TriggerID, hit.Action, hit.Text)
+
+		switch hit.Action {
+		case ActionEvent:
+			m.emitEvent(SessionEvent{SessionID: session.ID, Kind: EventTrigger, Trigger: &hit})
+
+		case ActionSendInput:
+			now := time.Now()
+			session.triggerMu.Lock()
+			if session.triggers.lastInput == nil {
+				session.triggers.lastInput = make(map[string]time.Time)
+			}
+			last := session.triggers.lastInput[hit.TriggerID]
+			recent := now.Sub(last) < triggerInputCooldown
+			if !recent {
+				session.triggers.lastInput[hit.TriggerID] = now
+			}
+			session.triggerMu.Unlock()
+			if recent {
+				log.Printf("session %s: trigger %s fired again within %v, not sending input", session.ID, hit.TriggerID, triggerInputCooldown)
+				continue
+			}
+			// Off the output path: the write can block until the program
+			// reads its input.
+			go func(access Access, input string) {
+				if err := m.WriteInputAs(session.ID, access, []byte(input)); err != nil {
+					log.Printf("session %s: trigger failed to send input: %v", session.ID, err)
+				}
+			}(*hit.access, hit.Argument)
+
+		case ActionFailBlock:
+			if hit.CommandID == "" {
+				continue // no block to mark
+			}
+			if hit.access != nil && hit.access.CheckWrite() != nil {
+				log.Printf("session %s: trigger %s lost its access to the session, not failing the block", session.ID, hit.TriggerID)
+				continue
+			}
+			session.triggerMu.Lock()
+			session.triggers.failBlock = hit.CommandID
+			session.triggers.failBlockBy = hit.TriggerID
+			session.triggerMu.Unlock()
+		}
+	}
+}
+
+// applyFailBlock reports a block completing successfully as failed if a
+// fail_block trigger matched its output. The exit code stays the command's
+// own; FailedBy tells such a failure apart.
+func (s *Session) applyFailBlock(chunk *OutputChunk) {
+	if chunk.Status != CommandCompleted {
+		return
+	}
+
+	s.triggerMu.Lock()
+	defer s.triggerMu.Unlock()
+
+	if s.triggers.failBlock != "" && s.triggers.failBlock == chunk.CommandID {
+		chunk.Status = CommandFailed
+		chunk.FailedBy = s.triggers.failBlockBy
+		s.triggers.failBlock, s.triggers.failBlockBy = "", ""
+	}
+}
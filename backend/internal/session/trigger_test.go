@@ -0,0 +1,270 @@
+package session
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/entl/blockterm/internal/storage"
+)
+
+// trigger builds a trigger watching every session.
+func trigger(id, pattern, action, argument string) Trigger {
+	return Trigger{
+		ID:       id,
+		Name:     id,
+		Pattern:  regexp.MustCompile(pattern),
+		Scope:    TriggerScopeAll,
+		Action:   action,
+		Argument: argument,
+	}
+}
+
+// TestCompileTriggerSendInput checks that send_input is only accepted for
+// triggers scoped to one session.
+func TestCompileTriggerSendInput(t *testing.T) {
+	tests := []struct {
+		scope, scopeID string
+		ok             bool
+	}{
+		{TriggerScopeSession, "s1", true},
+		{TriggerScopeProfile, "p1", false},
+		{TriggerScopeAll, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.scope, func(t *testing.T) {
+			_, err := CompileTrigger(&storage.Trigger{
+				Pattern: `\[y/N\]`, Scope: tt.scope, ScopeID: tt.scopeID, Action: ActionSendInput, Argument: "y\n",
+			})
+			if (err == nil) != tt.ok {
+				t.Errorf("CompileTrigger = %v, want accepted %v", err, tt.ok)
+			}
+		})
+	}
+}
+
+// TestMatchTriggers checks how output is split into lines for triggers:
+// matches may span reads, line endings are not part of the line, a carriage
+// return starts it over and each trigger fires at most once per line.
+func TestMatchTriggers(t *testing.T) {
+	tests := []struct {
+		name     string
+		triggers []Trigger
+		reads    []string
+		want     []string // "trigger@offset text"
+	}{
+		{
+			name:     "match split across reads",
+			triggers: []Trigger{trigger("err", `error`, ActionHighlight, "")},
+			reads:    []string{"build: err", "or in main.go\n"},
+			want:     []string{"err@7 error"},
+		},
+		{
+			name:     "once per line",
+			triggers: []Trigger{trigger("err", `error`, ActionHighlight, "")},
+			reads:    []string{"error error\nok\nerror\n"},
+			want:     []string{"err@0 error", "err@15 error"},
+		},
+		{
+			name:     "once per line across reads",
+			triggers: []Trigger{trigger("err", `error`, ActionHighlight, "")},
+			reads:    []string{"error ", "error\n"},
+			want:     []string{"err@0 error"},
+		},
+		{
+			name:     "line ending is not matched",
+			triggers: []Trigger{trigger("done", `done$`, ActionHighlight, "")},
+			reads:    []string{"done\r\n"},
+			want:     []string{"done@0 done"},
+		},
+		{
+			name:     "carriage return starts the line over",
+			triggers: []Trigger{trigger("full", `^100%`, ActionHighlight, "")},
+			reads:    []string{"50%\r", "100%\n"},
+			want:     []string{"full@4 100%"},
+		},
+		{
+			name:     "overwritten text does not match",
+			triggers: []Trigger{trigger("err", `error`, ActionHighlight, "")},
+			reads:    []string{"error\rok   \n"},
+		},
+		{
+			name: "several triggers on one line",
+			triggers: []Trigger{
+				trigger("warn", `warning`, ActionHighlight, ""),
+				trigger("err", `error`, ActionBell, ""),
+			},
+			reads: []string{"warning: error\n"},
+			want:  []string{"warn@0 warning", "err@9 error"},
+		},
+		{
+			name: "other session's trigger",
+			triggers: []Trigger{{
+				ID: "other", Pattern: regexp.MustCompile(`error`), Scope: TriggerScopeSession, ScopeID: "other", Action: ActionHighlight,
+			}},
+			reads: []string{"error\n"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewManager()
+			session, _ := newTestSession(t, m)
+			m.SetTriggers(tt.triggers)
+
+			var got []string
+			var offset int64
+			for _, read := range tt.reads {
+				hits := m.matchTriggers(session, OutputChunk{Data: []byte(read), Offset: offset})
+				for _, hit := range hits {
+					got = append(got, fmt.Sprintf("%s@%d %s", hit.TriggerID, hit.Offset, hit.Text))
+				}
+				offset += int64(len(read))
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("hits = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestMatchTriggersGap checks that output after a gap, such as a dropped
+// read, starts a new line.
+func TestMatchTriggersGap(t *testing.T) {
+	m := NewManager()
+	session, _ := newTestSession(t, m)
+	m.SetTriggers([]Trigger{trigger("err", `error`, ActionHighlight, "")})
+
+	if hits := m.matchTriggers(session, OutputChunk{Data: []byte("err"), Offset: 0}); len(hits) != 0 {
+		t.Fatalf("hits = %+v, want none", hits)
+	}
+	if hits := m.matchTriggers(session, OutputChunk{Data: []byte("or\n"), Offset: 10}); len(hits) != 0 {
+		t.Errorf("hits = %+v, want none across the gap", hits)
+	}
+}
+
+// TestTriggerSendInput checks that a send_input trigger types its argument
+// into the session with its creator's access, but not again within the
+// cooldown, and not at all without access or once that access is revoked.
+func TestTriggerSendInput(t *testing.T) {
+	m := NewManager()
+	session, _ := newTestSession(t, m)
+	input, pty, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { input.Close(); pty.Close() })
+	session.PTY = pty
+
+	confirm := func(access *Access) Trigger {
+		tr := trigger("confirm", `\[y/N\]`, ActionSendInput, "y\n")
+		tr.Scope, tr.ScopeID, tr.Access = TriggerScopeSession, session.ID, access
+		return tr
+	}
+	resetCooldown := func() {
+		session.triggerMu.Lock()
+		session.triggers.lastInput["confirm"] = time.Now().Add(-triggerInputCooldown)
+		session.triggerMu.Unlock()
+	}
+	expectInput := func(want string) {
+		t.Helper()
+		input.SetReadDeadline(time.Now().Add(5 * time.Second))
+		buf := make([]byte, 64)
+		n, err := input.Read(buf)
+		if err != nil || string(buf[:n]) != want {
+			t.Fatalf("input = %q, %v; want %q", buf[:n], err, want)
+		}
+	}
+	expectNoInput := func() {
+		t.Helper()
+		input.SetReadDeadline(time.Now().Add(100 * time.Millisecond))
+		buf := make([]byte, 64)
+		if n, err := input.Read(buf); !errors.Is(err, os.ErrDeadlineExceeded) {
+			t.Fatalf("input = %q, %v; want none", buf[:n], err)
+		}
+	}
+
+	m.SetTriggers([]Trigger{confirm(&Access{})})
+	m.publish(session, []OutputChunk{{Data: []byte("Continue? [y/N] ")}})
+	expectInput("y\n")
+
+	// The answer echoed back and asked again at once: no second answer.
+	m.publish(session, []OutputChunk{{Data: []byte("y\nContinue? [y/N] ")}})
+	expectNoInput()
+
+	resetCooldown()
+	m.publish(session, []OutputChunk{{Data: []byte("\nContinue? [y/N] ")}})
+	expectInput("y\n")
+
+	// Without access the trigger does nothing.
+	resetCooldown()
+	m.SetTriggers([]Trigger{confirm(nil)})
+	m.publish(session, []OutputChunk{{Data: []byte("\nContinue? [y/N] ")}})
+	expectNoInput()
+
+	// Created with a share that is then revoked.
+	shareID, token := newShare(t, m, session, RoleInteractive)
+	access, err := m.Authorize(session.ID, token)
+	if err != nil {
+		t.Fatalf("Authorize: %v", err)
+	}
+	m.SetTriggers([]Trigger{confirm(&access)})
+	if err := m.RevokeShare(session.ID, shareID); err != nil {
+		t.Fatalf("RevokeShare: %v", err)
+	}
+	resetCooldown()
+	m.publish(session, []OutputChunk{{Data: []byte("\nContinue? [y/N] ")}})
+	expectNoInput()
+}
+
+// TestTriggerFailBlock checks that a fail_block trigger turns its block's
+// successful completion into a failure that names the trigger, and leaves
+// other blocks alone.
+func TestTriggerFailBlock(t *testing.T) {
+	m := NewManager()
+	session, _ := newTestSession(t, m)
+	m.SetTriggers([]Trigger{trigger("fail", `FAIL`, ActionFailBlock, "")})
+
+	sub, _, err := m.Subscribe(session.ID, Position{}, OverflowDropGap)
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+	defer m.Unsubscribe(session.ID, sub)
+
+	// end runs a block with output and returns the chunk reporting how it
+	// ended.
+	end := func(commandID, output string) OutputChunk {
+		t.Helper()
+		m.publish(session, []OutputChunk{
+			{CommandID: commandID, Status: CommandRunning},
+			{CommandID: commandID, Data: []byte(output)},
+			{CommandID: commandID, Status: CommandCompleted},
+		})
+		for {
+			select {
+			case chunk := <-sub.C:
+				if len(chunk.Data) == 0 && chunk.Trigger == nil && chunk.Status != CommandRunning {
+					return chunk
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatalf("block %s did not complete", commandID)
+			}
+		}
+	}
+
+	if got := end("cmd1", "--- FAIL: TestX\n"); got.Status != CommandFailed || got.FailedBy != "fail" || got.ExitCode != 0 {
+		t.Errorf("block with a match = %s by %q, exit %d; want %s by \"fail\", exit 0", got.Status, got.FailedBy, got.ExitCode, CommandFailed)
+	}
+	if got := end("cmd2", "ok\n"); got.Status != CommandCompleted || got.FailedBy != "" {
+		t.Errorf("next block = %s by %q, want %s", got.Status, got.FailedBy, CommandCompleted)
+	}
+
+	// A match outside any block has nothing to fail.
+	m.publish(session, []OutputChunk{{Data: []byte("FAIL\n")}})
+	if got := end("cmd3", "ok\n"); got.Status != CommandCompleted {
+		t.Errorf("block after a match outside blocks = %s, want %s", got.Status, CommandCompleted)
+	}
+}
@@ -0,0 +1,248 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	pb "github.com/entl/blockterm/gen/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxWaitBuffer bounds the output a wait keeps to match against. Once it is
+// exceeded the older half is dropped, so a match straddling the cut is
+// missed.
+const maxWaitBuffer = 1 << 20 // 1 MiB
+
+// Why WaitForOutput returned, reported in WaitResult.Reason.
+const (
+	WaitMatched       = "match"          // the output matched the pattern
+	WaitBlockComplete = "block_complete" // a command block finished
+	WaitTimeout       = "timeout"        // the timeout elapsed first
+	WaitExited        = "exited"         // the session's shell ended first
+)
+
+// WaitOptions configures WaitForOutput. At least one of Pattern and
+// BlockComplete must be set; whichever condition is met first ends the wait.
+type WaitOptions struct {
+	Pattern       *regexp.Regexp // matched against the output, markers stripped
+	BlockComplete bool           // stop when a command block completes
+	CommandID     string         // with BlockComplete, only this block counts
+	FromOffset    int64          // output offset to start at, as in Subscribe
+	Timeout       time.Duration  // 0 waits until the context ends
+}
+
+// WaitResult reports what ended a wait.
+type WaitResult struct {
+	Reason    string
+	Match     []byte       // the text Pattern matched
+	Groups    []string     // Pattern's submatches
+	Offset    int64        // where the match, or the completed block's end, is in the output
+	EndOffset int64        // where to continue from: the end of the match, or of the output seen
+	Block     *OutputChunk // the chunk completing the block, for WaitBlockComplete
+	Exit      *ExitStatus  // for WaitExited
+}
+
+// WaitForOutput blocks until a session's output from opts.FromOffset
+// onwards matches opts.Pattern, a command block completes, the timeout
+// elapses or the shell ends. Retained scrollback counts, so output produced
+// just before the call is not missed.
+func (m *Manager) WaitForOutput(ctx context.Context, sessionID string, opts WaitOptions) (WaitResult, error) {
+	if opts.Pattern == nil && !opts.BlockComplete {
+		return WaitResult{}, fmt.Errorf("nothing to wait for")
+	}
+
+	// A wait is not a client of the session, so it must not keep an
+	// otherwise detached session alive.
+	sub, replay, err := m.watch(sessionID, Position{Offset: opts.FromOffset}, OverflowDropGap)
+	if err != nil {
+		return WaitResult{}, err
+	}
+	defer m.Unsubscribe(sessionID, sub)
+
+	var timeout <-chan time.Time
+	if opts.Timeout > 0 {
+		timer := time.NewTimer(opts.Timeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	w := &outputWaiter{opts: opts, base: opts.FromOffset}
+	for _, chunk := range replay {
+		if res, ok := w.feed(chunk); ok {
+			return res, nil
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return WaitResult{}, ctx.Err()
+
+		case <-timeout:
+			return w.result(WaitTimeout), nil
+
+		case chunk := <-sub.C:
+			if res, ok := w.feed(chunk); ok {
+				return res, nil
+			}
+
+		case <-sub.Done():
+			// The shell's last output may still match.
+			for len(sub.C) > 0 {
+				if res, ok := w.feed(<-sub.C); ok {
+					return res, nil
+				}
+			}
+			var exit *ExitError
+			if errors.As(sub.Err(), &exit) {
+				res := w.result(WaitExited)
+				res.Exit = &exit.Status
+				return res, nil
+			}
+			if err := sub.Err(); err != nil {
+				return WaitResult{}, err
+			}
+			return WaitResult{}, fmt.Errorf("session detached: %s", sessionID)
+		}
+	}
+}
+
+// outputWaiter accumulates a session's output and checks each chunk
+// against a wait's conditions.
+type outputWaiter struct {
+	opts WaitOptions
+	buf  []byte // output not yet ruled out, contiguous
+	base int64  // output offset of buf[0]
+}
+
+// feed adds a chunk and reports whether it ends the wait.
+func (w *outputWaiter) feed(chunk OutputChunk) (WaitResult, bool) {
+	if w.opts.BlockComplete && (chunk.Status == CommandCompleted || chunk.Status == CommandFailed) &&
+		(w.opts.CommandID == "" || chunk.CommandID == w.opts.CommandID) && chunk.Offset >= w.opts.FromOffset {
+		res := w.result(WaitBlockComplete)
+		res.Offset, res.EndOffset = chunk.Offset, chunk.Offset
+		res.Block = &chunk
+		return res, true
+	}
+	if len(chunk.Data) == 0 || w.opts.Pattern == nil {
+		return WaitResult{}, false
+	}
+
+	data, offset := chunk.Data, chunk.Offset
+	end := w.base + int64(len(w.buf))
+	switch {
+	case offset < end:
+		// Already seen, or before FromOffset.
+		if end-offset >= int64(len(data)) {
+			return WaitResult{}, false
+		}
+		data = data[end-offset:]
+	case offset > end:
+		// Output was dropped or evicted from the scrollback; a match
+		// cannot span the gap.
+		w.buf = w.buf[:0]
+		w.base = offset
+	}
+	w.buf = append(w.buf, data...)
+	if len(w.buf) > maxWaitBuffer {
+		cut := len(w.buf) - maxWaitBuffer/2
+		w.buf = append([]byte(nil), w.buf[cut:]...)
+		w.base += int64(cut)
+	}
+
+	loc := w.opts.Pattern.FindSubmatchIndex(w.buf)
+	if loc == nil {
+		return WaitResult{}, false
+	}
+	res := WaitResult{
+		Reason:    WaitMatched,
+		Match:     append([]byte(nil), w.buf[loc[0]:loc[1]]...),
+		Offset:    w.base + int64(loc[0]),
+		EndOffset: w.base + int64(loc[1]),
+	}
+	for i := 2; i < len(loc); i += 2 {
+		group := ""
+		if loc[i] >= 0 {
+			group = string(w.buf[loc[i]:loc[i+1]])
+		}
+		res.Groups = append(res.Groups, group)
+	}
+	return res, true
+}
+
+// result reports a wait ending for reason at the end of the output seen.
+func (w *outputWaiter) result(reason string) WaitResult {
+	end := max(w.base+int64(len(w.buf)), w.opts.FromOffset)
+	return WaitResult{Reason: reason, Offset: end, EndOffset: end}
+}
+
+// WaitForOutput blocks until a session's output matches a pattern or
+// literal, a command block completes, or the timeout elapses. Timeouts and
+// the shell ending are reported in the response rather than as errors.
+func (s *Service) WaitForOutput(ctx context.Context, req *pb.WaitForOutputRequest) (*pb.WaitForOutputResponse, error) {
+	if req.SessionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "session_id is required")
+	}
+	if req.Pattern != "" && req.Literal != "" {
+		return nil, status.Error(codes.InvalidArgument, "pattern and literal are mutually exclusive")
+	}
+	if req.Pattern == "" && req.Literal == "" && !req.BlockComplete {
+		return nil, status.Error(codes.InvalidArgument, "one of pattern, literal or block_complete is required")
+	}
+	if req.CommandId != "" && !req.BlockComplete {
+		return nil, status.Error(codes.InvalidArgument, "command_id requires block_complete")
+	}
+
+	opts := WaitOptions{
+		BlockComplete: req.BlockComplete,
+		CommandID:     req.CommandId,
+		FromOffset:    int64(req.FromOffset),
+		Timeout:       time.Duration(req.TimeoutMs) * time.Millisecond,
+	}
+	switch {
+	case req.Pattern != "":
+		re, err := regexp.Compile(req.Pattern)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid pattern: %v", err)
+		}
+		opts.Pattern = re
+	case req.Literal != "":
+		opts.Pattern = regexp.MustCompile(regexp.QuoteMeta(req.Literal))
+	}
+
+	if _, err := s.authorize(req.SessionId, req.ShareToken); err != nil {
+		return nil, err
+	}
+
+	res, err := s.manager.WaitForOutput(ctx, req.SessionId, opts)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, status.FromContextError(ctx.Err()).Err()
+		}
+		log.Printf("session %s: wait for output failed: %v", req.SessionId, err)
+		return nil, status.Errorf(codes.FailedPrecondition, "wait for output failed: %v", err)
+	}
+
+	resp := &pb.WaitForOutputResponse{
+		Reason:    res.Reason,
+		Matched:   res.Match,
+		Groups:    res.Groups,
+		Offset:    uint64(res.Offset),
+		EndOffset: uint64(res.EndOffset),
+	}
+	if res.Block != nil {
+		resp.CommandId = res.Block.CommandID
+		resp.Status = res.Block.Status
+		resp.ExitCode = int32(res.Block.ExitCode)
+		resp.FailedBy = res.Block.FailedBy
+	}
+	if res.Exit != nil {
+		resp.Exit = toProtoExit(*res.Exit)
+	}
+	return resp, nil
+}
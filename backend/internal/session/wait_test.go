@@ -0,0 +1,217 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"slices"
+	"testing"
+	"time"
+)
+
+// TestOutputWaiter checks how a wait matches the chunks it is fed: across
+// chunk boundaries, from an offset, without counting replayed output twice,
+// and on completed blocks.
+func TestOutputWaiter(t *testing.T) {
+	data := func(offset int64, s string) OutputChunk {
+		return OutputChunk{Data: []byte(s), Offset: offset}
+	}
+	completed := func(offset int64, commandID string) OutputChunk {
+		return OutputChunk{CommandID: commandID, Status: CommandCompleted, Offset: offset}
+	}
+
+	tests := []struct {
+		name   string
+		opts   WaitOptions
+		chunks []OutputChunk
+		want   *WaitResult // nil if the wait goes on
+	}{
+		{
+			name:   "match split across chunks",
+			opts:   WaitOptions{Pattern: regexp.MustCompile(`password:`)},
+			chunks: []OutputChunk{data(0, "$ sudo ls\npass"), data(14, "word: ")},
+			want:   &WaitResult{Reason: WaitMatched, Match: []byte("password:"), Offset: 10, EndOffset: 19},
+		},
+		{
+			name:   "submatches",
+			opts:   WaitOptions{Pattern: regexp.MustCompile(`v(\d+)\.(\d+)(-rc)?`)},
+			chunks: []OutputChunk{data(0, "version v1.24\n")},
+			want:   &WaitResult{Reason: WaitMatched, Match: []byte("v1.24"), Groups: []string{"1", "24", ""}, Offset: 8, EndOffset: 13},
+		},
+		{
+			name:   "output before from offset is skipped",
+			opts:   WaitOptions{Pattern: regexp.MustCompile(`done`), FromOffset: 5},
+			chunks: []OutputChunk{data(0, "done\ndone\n")},
+			want:   &WaitResult{Reason: WaitMatched, Match: []byte("done"), Offset: 5, EndOffset: 9},
+		},
+		{
+			name:   "output entirely before from offset",
+			opts:   WaitOptions{Pattern: regexp.MustCompile(`done`), FromOffset: 5},
+			chunks: []OutputChunk{data(0, "done\n")},
+		},
+		{
+			name:   "overlapping output is not counted twice",
+			opts:   WaitOptions{Pattern: regexp.MustCompile(`abab`)},
+			chunks: []OutputChunk{data(0, "ab"), data(0, "abc")},
+		},
+		{
+			name:   "no match across a gap",
+			opts:   WaitOptions{Pattern: regexp.MustCompile(`foobar`)},
+			chunks: []OutputChunk{data(0, "foo"), data(10, "bar")},
+		},
+		{
+			name:   "any block completes",
+			opts:   WaitOptions{BlockComplete: true},
+			chunks: []OutputChunk{data(0, "make\n"), completed(5, "cmd1")},
+			want:   &WaitResult{Reason: WaitBlockComplete, Offset: 5, EndOffset: 5},
+		},
+		{
+			name:   "another block completes",
+			opts:   WaitOptions{BlockComplete: true, CommandID: "cmd2"},
+			chunks: []OutputChunk{completed(5, "cmd1")},
+		},
+		{
+			name:   "block completed before from offset",
+			opts:   WaitOptions{BlockComplete: true, FromOffset: 10},
+			chunks: []OutputChunk{completed(5, "cmd1")},
+		},
+		{
+			name:   "block completes before the pattern matches",
+			opts:   WaitOptions{Pattern: regexp.MustCompile(`ok`), BlockComplete: true},
+			chunks: []OutputChunk{data(0, "fail\n"), completed(5, "cmd1"), data(5, "ok")},
+			want:   &WaitResult{Reason: WaitBlockComplete, Offset: 5, EndOffset: 5},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &outputWaiter{opts: tt.opts, base: tt.opts.FromOffset}
+			var got *WaitResult
+			for _, chunk := range tt.chunks {
+				if res, ok := w.feed(chunk); ok {
+					got = &res
+					break
+				}
+			}
+
+			switch {
+			case tt.want == nil && got != nil:
+				t.Fatalf("wait ended with %+v, want it to go on", *got)
+			case tt.want == nil:
+				return
+			case got == nil:
+				t.Fatalf("wait goes on, want %+v", *tt.want)
+			}
+			if got.Reason != tt.want.Reason || string(got.Match) != string(tt.want.Match) ||
+				!slices.Equal(got.Groups, tt.want.Groups) || got.Offset != tt.want.Offset || got.EndOffset != tt.want.EndOffset {
+				t.Errorf("result = %s %q %q [%d, %d], want %s %q %q [%d, %d]",
+					got.Reason, got.Match, got.Groups, got.Offset, got.EndOffset,
+					tt.want.Reason, tt.want.Match, tt.want.Groups, tt.want.Offset, tt.want.EndOffset)
+			}
+		})
+	}
+}
+
+// TestWaitForOutput checks that a wait sees output produced before and
+// after it starts, can continue from a previous end offset, and times out
+// at the end of the output seen.
+func TestWaitForOutput(t *testing.T) {
+	m := NewManager()
+	session, _ := newTestSession(t, m)
+	ctx := context.Background()
+
+	// Already in the scrollback when the wait starts.
+	m.publish(session, []OutputChunk{{Data: []byte("login: ")}})
+	res, err := m.WaitForOutput(ctx, session.ID, WaitOptions{Pattern: regexp.MustCompile(`login:`), Timeout: time.Second})
+	if err != nil {
+		t.Fatalf("WaitForOutput: %v", err)
+	}
+	if res.Reason != WaitMatched || res.EndOffset != 6 {
+		t.Fatalf("result = %s ending at %d, want match ending at 6", res.Reason, res.EndOffset)
+	}
+
+	// Produced while waiting, from where the last wait ended.
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		m.publish(session, []OutputChunk{{Data: []byte("login: ")}})
+	}()
+	res, err = m.WaitForOutput(ctx, session.ID, WaitOptions{Pattern: regexp.MustCompile(`login:`), FromOffset: res.EndOffset, Timeout: 5 * time.Second})
+	if err != nil {
+		t.Fatalf("WaitForOutput: %v", err)
+	}
+	if res.Reason != WaitMatched || res.Offset != 7 {
+		t.Fatalf("result = %s at %d, want the second prompt at 7", res.Reason, res.Offset)
+	}
+
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		m.publish(session, []OutputChunk{{CommandID: "cmd1", Status: CommandFailed, ExitCode: 2}})
+	}()
+	res, err = m.WaitForOutput(ctx, session.ID, WaitOptions{BlockComplete: true, FromOffset: res.EndOffset, Timeout: 5 * time.Second})
+	if err != nil {
+		t.Fatalf("WaitForOutput: %v", err)
+	}
+	if res.Reason != WaitBlockComplete || res.Block == nil || res.Block.CommandID != "cmd1" || res.Block.ExitCode != 2 {
+		t.Fatalf("result = %s with block %+v, want cmd1 failing with 2", res.Reason, res.Block)
+	}
+
+	start := time.Now()
+	res, err = m.WaitForOutput(ctx, session.ID, WaitOptions{Pattern: regexp.MustCompile(`never`), Timeout: 50 * time.Millisecond})
+	if err != nil {
+		t.Fatalf("WaitForOutput: %v", err)
+	}
+	if res.Reason != WaitTimeout || res.EndOffset != 14 {
+		t.Errorf("result = %s ending at %d, want timeout at the end of the output, 14", res.Reason, res.EndOffset)
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("timed out after %v, want at least 50ms", elapsed)
+	}
+
+	if _, err := m.WaitForOutput(ctx, session.ID, WaitOptions{Timeout: time.Second}); err == nil {
+		t.Error("WaitForOutput with nothing to wait for succeeded")
+	}
+}
+
+// TestWaitForOutputNotAClient checks that a wait without a timeout lasts
+// until its context ends, and that meanwhile it neither counts as an
+// attached client nor keeps the session from being detached.
+func TestWaitForOutputNotAClient(t *testing.T) {
+	m := NewManager()
+	session, _ := newTestSession(t, m)
+	detachedAt := time.Now().Add(-time.Hour)
+	session.DetachedAt = detachedAt
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errc := make(chan error, 1)
+	go func() {
+		_, err := m.WaitForOutput(ctx, session.ID, WaitOptions{Pattern: regexp.MustCompile(`never`)})
+		errc <- err
+	}()
+
+	for deadline := time.Now().Add(5 * time.Second); ; time.Sleep(time.Millisecond) {
+		session.outputMu.RLock()
+		waiting := len(session.subscribers) == 1
+		session.outputMu.RUnlock()
+		if waiting {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("wait never subscribed")
+		}
+	}
+	if info := session.Info(); info.AttachedClients != 0 || !info.DetachedAt.Equal(detachedAt) {
+		t.Errorf("while waiting: %d attached clients, detached at %v; want 0 and %v", info.AttachedClients, info.DetachedAt, detachedAt)
+	}
+
+	select {
+	case err := <-errc:
+		t.Fatalf("wait without a timeout ended early: %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+	cancel()
+	if err := <-errc; !errors.Is(err, context.Canceled) {
+		t.Errorf("WaitForOutput = %v, want context.Canceled", err)
+	}
+	if info := session.Info(); !info.DetachedAt.Equal(detachedAt) {
+		t.Errorf("after the wait: detached at %v, want %v", info.DetachedAt, detachedAt)
+	}
+}
@@ -54,10 +54,74 @@ func (db *DB) initSchema() error {
 	CREATE INDEX IF NOT EXISTS idx_commands_ts ON commands(ts DESC);
 	CREATE INDEX IF NOT EXISTS idx_commands_session ON commands(session_id);
 	CREATE INDEX IF NOT EXISTS idx_commands_text ON commands(cmd_text);
+
+	CREATE TABLE IF NOT EXISTS profiles (
+		id TEXT PRIMARY KEY,
+		name TEXT NOT NULL UNIQUE,
+		shell TEXT NOT NULL,
+		args TEXT NOT NULL,
+		env TEXT NOT NULL,
+		cwd TEXT NOT NULL,
+		startup_commands TEXT NOT NULL,
+		created_at INTEGER NOT NULL,
+		updated_at INTEGER NOT NULL
+	);
+
+	CREATE TABLE IF NOT EXISTS triggers (
+		id TEXT PRIMARY KEY,
+		name TEXT NOT NULL,
+		pattern TEXT NOT NULL,
+		scope TEXT NOT NULL,
+		scope_id TEXT NOT NULL,
+		action TEXT NOT NULL,
+		argument TEXT NOT NULL,
+		enabled INTEGER NOT NULL,
+		created_at INTEGER NOT NULL,
+		updated_at INTEGER NOT NULL
+	);
 	`
 
-	_, err := db.conn.Exec(schema)
-	return err
+	if _, err := db.conn.Exec(schema); err != nil {
+		return err
+	}
+
+	// Columns added after the initial release; existing databases lack them.
+	return db.addColumnIfMissing("commands", "duration_ms", "INTEGER")
+}
+
+// addColumnIfMissing adds a nullable column to an existing table.
+func (db *DB) addColumnIfMissing(table, column, colType string) error {
+	rows, err := db.conn.Query(fmt.Sprintf("PRAGMA table_info(%s)", table))
+	if err != nil {
+		return fmt.Errorf("failed to inspect table %s: %w", table, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			cid       int
+			name      string
+			typ       string
+			notNull   int
+			dfltValue sql.NullString
+			pk        int
+		)
+		if err := rows.Scan(&cid, &name, &typ, &notNull, &dfltValue, &pk); err != nil {
+			return fmt.Errorf("failed to scan table info: %w", err)
+		}
+		if name == column {
+			return nil
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterating table info: %w", err)
+	}
+
+	_, err = db.conn.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", table, column, colType))
+	if err != nil {
+		return fmt.Errorf("failed to add column %s.%s: %w", table, column, err)
+	}
+	return nil
 }
 
 // Close closes the database connection.
@@ -71,10 +135,15 @@ func (db *DB) Close() error {
 // InsertCommand inserts a new command record into the database.
 func (db *DB) InsertCommand(ctx context.Context, cmd *Command) error {
 	query := `
-		INSERT INTO commands (ts, session_id, shell, cwd, cmd_text, exit_code, created_at)
-		VALUES (?, ?, ?, ?, ?, ?, ?)
+		INSERT INTO commands (ts, session_id, shell, cwd, cmd_text, exit_code, duration_ms, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
 	`
 
+	var durationMs sql.NullInt64
+	if cmd.Duration != nil {
+		durationMs = sql.NullInt64{Int64: cmd.Duration.Milliseconds(), Valid: true}
+	}
+
 	result, err := db.conn.ExecContext(ctx, query,
 		cmd.Timestamp.Unix(),
 		cmd.SessionID,
@@ -82,6 +151,7 @@ func (db *DB) InsertCommand(ctx context.Context, cmd *Command) error {
 		cmd.Cwd,
 		cmd.CommandText,
 		cmd.ExitCode,
+		durationMs,
 		time.Now().Unix(),
 	)
 	if err != nil {
@@ -100,7 +170,7 @@ func (db *DB) InsertCommand(ctx context.Context, cmd *Command) error {
 // GetRecentCommands retrieves the N most recent commands.
 func (db *DB) GetRecentCommands(ctx context.Context, limit int) ([]*Command, error) {
 	query := `
-		SELECT id, ts, session_id, shell, cwd, cmd_text, exit_code
+		SELECT id, ts, session_id, shell, cwd, cmd_text, exit_code, duration_ms
 		FROM commands
 		ORDER BY ts DESC
 		LIMIT ?
@@ -118,7 +188,7 @@ func (db *DB) GetRecentCommands(ctx context.Context, limit int) ([]*Command, err
 // SearchCommands searches for commands matching a prefix or containing a substring.
 func (db *DB) SearchCommands(ctx context.Context, pattern string, limit int) ([]*Command, error) {
 	query := `
-		SELECT id, ts, session_id, shell, cwd, cmd_text, exit_code
+		SELECT id, ts, session_id, shell, cwd, cmd_text, exit_code, duration_ms
 		FROM commands
 		WHERE cmd_text LIKE ?
 		ORDER BY ts DESC
@@ -137,7 +207,7 @@ func (db *DB) SearchCommands(ctx context.Context, pattern string, limit int) ([]
 // GetCommandsBySession retrieves all commands for a specific session.
 func (db *DB) GetCommandsBySession(ctx context.Context, sessionID string, limit int) ([]*Command, error) {
 	query := `
-		SELECT id, ts, session_id, shell, cwd, cmd_text, exit_code
+		SELECT id, ts, session_id, shell, cwd, cmd_text, exit_code, duration_ms
 		FROM commands
 		WHERE session_id = ?
 		ORDER BY ts DESC
@@ -161,6 +231,7 @@ func (db *DB) scanCommands(rows *sql.Rows) ([]*Command, error) {
 		var cmd Command
 		var tsUnix int64
 		var exitCode sql.NullInt64
+		var durationMs sql.NullInt64
 
 		err := rows.Scan(
 			&cmd.ID,
@@ -170,6 +241,7 @@ func (db *DB) scanCommands(rows *sql.Rows) ([]*Command, error) {
 			&cmd.Cwd,
 			&cmd.CommandText,
 			&exitCode,
+			&durationMs,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan command row: %w", err)
@@ -180,6 +252,10 @@ func (db *DB) scanCommands(rows *sql.Rows) ([]*Command, error) {
 			val := int(exitCode.Int64)
 			cmd.ExitCode = &val
 		}
+		if durationMs.Valid {
+			d := time.Duration(durationMs.Int64) * time.Millisecond
+			cmd.Duration = &d
+		}
 
 		commands = append(commands, &cmd)
 	}
@@ -191,11 +267,11 @@ func (db *DB) scanCommands(rows *sql.Rows) ([]*Command, error) {
 	return commands, nil
 }
 
-// UpdateExitCode updates the exit code for a command.
-func (db *DB) UpdateExitCode(ctx context.Context, cmdID int64, exitCode int) error {
-	query := `UPDATE commands SET exit_code = ? WHERE id = ?`
+// UpdateExitCode updates the exit code and duration for a command.
+func (db *DB) UpdateExitCode(ctx context.Context, cmdID int64, exitCode int, duration time.Duration) error {
+	query := `UPDATE commands SET exit_code = ?, duration_ms = ? WHERE id = ?`
 
-	_, err := db.conn.ExecContext(ctx, query, exitCode, cmdID)
+	_, err := db.conn.ExecContext(ctx, query, exitCode, duration.Milliseconds(), cmdID)
 	if err != nil {
 		return fmt.Errorf("failed to update exit code: %w", err)
 	}
@@ -12,7 +12,38 @@ type Command struct {
 	Shell       string
 	Cwd         string
 	CommandText string
-	ExitCode    *int // nullable, may not be available immediately
+	ExitCode    *int           // nullable, may not be available immediately
+	Duration    *time.Duration // nullable, set once the command completes
+}
+
+// Profile is a named bundle of session start options. Args, Env and
+// StartupCommands are stored as JSON.
+type Profile struct {
+	ID              string
+	Name            string
+	Shell           string // empty for the default shell
+	Args            []string
+	Env             map[string]string
+	Cwd             string
+	StartupCommands []string // typed into the shell once it has started
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// Trigger is a stored output trigger: when a session's output matches
+// Pattern, Action is taken. The values of Scope and Action are defined and
+// validated by the session package.
+type Trigger struct {
+	ID        string
+	Name      string
+	Pattern   string // regular expression matched against each output line
+	Scope     string // "all", "profile" or "session"
+	ScopeID   string // profile or session ID for the narrower scopes
+	Action    string
+	Argument  string // input to send, or highlight style
+	Enabled   bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
 }
 
 // QueryOptions provides filtering options for command queries.
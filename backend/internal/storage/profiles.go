@@ -0,0 +1,194 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrProfileNotFound is returned for a profile ID that does not exist.
+	ErrProfileNotFound = errors.New("profile not found")
+	// ErrProfileExists is returned when another profile has the same name.
+	ErrProfileExists = errors.New("a profile with that name already exists")
+)
+
+// CreateProfile stores a new profile, assigning its ID and timestamps.
+func (db *DB) CreateProfile(ctx context.Context, p *Profile) error {
+	args, env, startup, err := encodeProfile(p)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	id := uuid.New().String()
+	query := `
+		INSERT INTO profiles (id, name, shell, args, env, cwd, startup_commands, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+	_, err = db.conn.ExecContext(ctx, query,
+		id, p.Name, p.Shell, args, env, p.Cwd, startup, now.Unix(), now.Unix())
+	if err != nil {
+		return profileWriteError("insert", err)
+	}
+
+	p.ID = id
+	p.CreatedAt = time.Unix(now.Unix(), 0)
+	p.UpdatedAt = p.CreatedAt
+	return nil
+}
+
+// UpdateProfile replaces every field of an existing profile but its ID and
+// creation time.
+func (db *DB) UpdateProfile(ctx context.Context, p *Profile) error {
+	args, env, startup, err := encodeProfile(p)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	query := `
+		UPDATE profiles
+		SET name = ?, shell = ?, args = ?, env = ?, cwd = ?, startup_commands = ?, updated_at = ?
+		WHERE id = ?
+	`
+	result, err := db.conn.ExecContext(ctx, query,
+		p.Name, p.Shell, args, env, p.Cwd, startup, now.Unix(), p.ID)
+	if err != nil {
+		return profileWriteError("update", err)
+	}
+	if n, err := result.RowsAffected(); err == nil && n == 0 {
+		return ErrProfileNotFound
+	}
+
+	updated, err := db.GetProfile(ctx, p.ID)
+	if err != nil {
+		return err
+	}
+	*p = *updated
+	return nil
+}
+
+// DeleteProfile removes a profile.
+func (db *DB) DeleteProfile(ctx context.Context, id string) error {
+	result, err := db.conn.ExecContext(ctx, `DELETE FROM profiles WHERE id = ?`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete profile: %w", err)
+	}
+	if n, err := result.RowsAffected(); err == nil && n == 0 {
+		return ErrProfileNotFound
+	}
+	return nil
+}
+
+// GetProfile retrieves a profile by ID.
+func (db *DB) GetProfile(ctx context.Context, id string) (*Profile, error) {
+	query := `
+		SELECT id, name, shell, args, env, cwd, startup_commands, created_at, updated_at
+		FROM profiles
+		WHERE id = ?
+	`
+	rows, err := db.conn.QueryContext(ctx, query, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query profile: %w", err)
+	}
+	defer rows.Close()
+
+	profiles, err := scanProfiles(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(profiles) == 0 {
+		return nil, ErrProfileNotFound
+	}
+	return profiles[0], nil
+}
+
+// ListProfiles retrieves every profile, ordered by name.
+func (db *DB) ListProfiles(ctx context.Context) ([]*Profile, error) {
+	query := `
+		SELECT id, name, shell, args, env, cwd, startup_commands, created_at, updated_at
+		FROM profiles
+		ORDER BY name
+	`
+	rows, err := db.conn.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query profiles: %w", err)
+	}
+	defer rows.Close()
+
+	return scanProfiles(rows)
+}
+
+// scanProfiles scans rows into Profile structs.
+func scanProfiles(rows *sql.Rows) ([]*Profile, error) {
+	var profiles []*Profile
+
+	for rows.Next() {
+		var p Profile
+		var args, env, startup string
+		var createdAt, updatedAt int64
+
+		err := rows.Scan(&p.ID, &p.Name, &p.Shell, &args, &env, &p.Cwd, &startup, &createdAt, &updatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan profile row: %w", err)
+		}
+		if err := json.Unmarshal([]byte(args), &p.Args); err != nil {
+			return nil, fmt.Errorf("profile %s: bad args: %w", p.ID, err)
+		}
+		if err := json.Unmarshal([]byte(env), &p.Env); err != nil {
+			return nil, fmt.Errorf("profile %s: bad env: %w", p.ID, err)
+		}
+		if err := json.Unmarshal([]byte(startup), &p.StartupCommands); err != nil {
+			return nil, fmt.Errorf("profile %s: bad startup commands: %w", p.ID, err)
+		}
+		p.CreatedAt = time.Unix(createdAt, 0)
+		p.UpdatedAt = time.Unix(updatedAt, 0)
+
+		profiles = append(profiles, &p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating profile rows: %w", err)
+	}
+
+	return profiles, nil
+}
+
+// encodeProfile encodes the JSON columns of a profile. Nil fields are
+// stored as empty values rather than null.
+func encodeProfile(p *Profile) (args, env, startup string, err error) {
+	profileArgs, profileEnv, profileStartup := p.Args, p.Env, p.StartupCommands
+	if profileArgs == nil {
+		profileArgs = []string{}
+	}
+	if profileEnv == nil {
+		profileEnv = map[string]string{}
+	}
+	if profileStartup == nil {
+		profileStartup = []string{}
+	}
+
+	var data [3][]byte
+	for i, v := range []any{profileArgs, profileEnv, profileStartup} {
+		if data[i], err = json.Marshal(v); err != nil {
+			return "", "", "", fmt.Errorf("failed to encode profile: %w", err)
+		}
+	}
+	return string(data[0]), string(data[1]), string(data[2]), nil
+}
+
+// profileWriteError maps a failed insert or update to ErrProfileExists when
+// it broke the unique name constraint.
+func profileWriteError(op string, err error) error {
+	if strings.Contains(err.Error(), "UNIQUE constraint failed") {
+		return ErrProfileExists
+	}
+	return fmt.Errorf("failed to %s profile: %w", op, err)
+}
@@ -0,0 +1,136 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrTriggerNotFound is returned for a trigger ID that does not exist.
+var ErrTriggerNotFound = errors.New("trigger not found")
+
+// CreateTrigger stores a new trigger, assigning its ID and timestamps.
+func (db *DB) CreateTrigger(ctx context.Context, t *Trigger) error {
+	now := time.Now()
+	id := uuid.New().String()
+	query := `
+		INSERT INTO triggers (id, name, pattern, scope, scope_id, action, argument, enabled, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+	_, err := db.conn.ExecContext(ctx, query,
+		id, t.Name, t.Pattern, t.Scope, t.ScopeID, t.Action, t.Argument, t.Enabled, now.Unix(), now.Unix())
+	if err != nil {
+		return fmt.Errorf("failed to insert trigger: %w", err)
+	}
+
+	t.ID = id
+	t.CreatedAt = time.Unix(now.Unix(), 0)
+	t.UpdatedAt = t.CreatedAt
+	return nil
+}
+
+// UpdateTrigger replaces every field of an existing trigger but its ID and
+// creation time.
+func (db *DB) UpdateTrigger(ctx context.Context, t *Trigger) error {
+	now := time.Now()
+	query := `
+		UPDATE triggers
+		SET name = ?, pattern = ?, scope = ?, scope_id = ?, action = ?, argument = ?, enabled = ?, updated_at = ?
+		WHERE id = ?
+	`
+	result, err := db.conn.ExecContext(ctx, query,
+		t.Name, t.Pattern, t.Scope, t.ScopeID, t.Action, t.Argument, t.Enabled, now.Unix(), t.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update trigger: %w", err)
+	}
+	if n, err := result.RowsAffected(); err == nil && n == 0 {
+		return ErrTriggerNotFound
+	}
+
+	updated, err := db.GetTrigger(ctx, t.ID)
+	if err != nil {
+		return err
+	}
+	*t = *updated
+	return nil
+}
+
+// DeleteTrigger removes a trigger.
+func (db *DB) DeleteTrigger(ctx context.Context, id string) error {
+	result, err := db.conn.ExecContext(ctx, `DELETE FROM triggers WHERE id = ?`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete trigger: %w", err)
+	}
+	if n, err := result.RowsAffected(); err == nil && n == 0 {
+		return ErrTriggerNotFound
+	}
+	return nil
+}
+
+// GetTrigger retrieves a trigger by ID.
+func (db *DB) GetTrigger(ctx context.Context, id string) (*Trigger, error) {
+	query := `
+		SELECT id, name, pattern, scope, scope_id, action, argument, enabled, created_at, updated_at
+		FROM triggers
+		WHERE id = ?
+	`
+	rows, err := db.conn.QueryContext(ctx, query, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query trigger: %w", err)
+	}
+	defer rows.Close()
+
+	triggers, err := scanTriggers(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(triggers) == 0 {
+		return nil, ErrTriggerNotFound
+	}
+	return triggers[0], nil
+}
+
+// ListTriggers retrieves every trigger, oldest first.
+func (db *DB) ListTriggers(ctx context.Context) ([]*Trigger, error) {
+	query := `
+		SELECT id, name, pattern, scope, scope_id, action, argument, enabled, created_at, updated_at
+		FROM triggers
+		ORDER BY created_at, id
+	`
+	rows, err := db.conn.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query triggers: %w", err)
+	}
+	defer rows.Close()
+
+	return scanTriggers(rows)
+}
+
+// scanTriggers scans rows into Trigger structs.
+func scanTriggers(rows *sql.Rows) ([]*Trigger, error) {
+	var triggers []*Trigger
+
+	for rows.Next() {
+		var t Trigger
+		var createdAt, updatedAt int64
+
+		err := rows.Scan(&t.ID, &t.Name, &t.Pattern, &t.Scope, &t.ScopeID, &t.Action, &t.Argument, &t.Enabled, &createdAt, &updatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan trigger row: %w", err)
+		}
+		t.CreatedAt = time.Unix(createdAt, 0)
+		t.UpdatedAt = time.Unix(updatedAt, 0)
+
+		triggers = append(triggers, &t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating trigger rows: %w", err)
+	}
+
+	return triggers, nil
+}
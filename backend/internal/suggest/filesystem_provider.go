@@ -34,7 +34,7 @@ func (p *FilesystemProvider) GetSuggestions(ctx context.Context, input string, c
 	cwd := homeDir()
 	if sessionID != "" {
 		if sess, err := p.sessionMgr.GetSession(sessionID); err == nil {
-			cwd = sess.Cwd
+			cwd = sess.CurrentCwd()
 		}
 	}
 
@@ -4,10 +4,13 @@
 
 import * as grpc from '@grpc/grpc-js';
 import * as protoLoader from '@grpc/proto-loader';
+import fs from 'fs';
+import os from 'os';
 import path from 'path';
 import { app } from 'electron';
 import { EventEmitter } from 'events';
 import { isDev } from './utils.js';
+import type { OutputMeta, ProcessInfo, SessionInfo } from '../shared/types.js';
 
 // Proto file path – in dev, resolve from project root; in production, from Resources/
 const PROTO_PATH = isDev()
@@ -26,6 +29,11 @@ const packageDefinition = protoLoader.loadSync(PROTO_PATH, {
 const protoDescriptor = grpc.loadPackageDefinition(packageDefinition) as any;
 const blockterm = protoDescriptor.blockterm;
 
+// The backend writes a fresh server token here each time it starts; every
+// RPC not scoped to a session by its own tokens requires it.
+const SERVER_TOKEN_PATH = path.join(os.homedir(), '.blockterm', 'server-token');
+const SERVER_TOKEN_HEADER = 'blockterm-server-token';
+
 export interface GrpcClientOptions {
   address: string;
 }
@@ -37,17 +45,34 @@ export class GrpcClient extends EventEmitter {
   private systemClient: any;
   private address: string;
   private outputStreams: Map<string, grpc.ClientReadableStream<any>> = new Map();
+  // Owner tokens of the sessions this client started, sent as shareToken
+  // with every request about them. They never leave the main process.
+  private ownerTokens: Map<string, string> = new Map();
 
   constructor(options: GrpcClientOptions) {
     super();
     this.address = options.address;
     
     const credentials = grpc.credentials.createInsecure();
+    // Call credentials need TLS, so the server token goes in with an
+    // interceptor instead. The backend is ready, so the file is current.
+    const serverToken = fs.readFileSync(SERVER_TOKEN_PATH, 'utf8').trim();
+    const clientOptions = {
+      interceptors: [
+        (options: any, nextCall: any) =>
+          new grpc.InterceptingCall(nextCall(options), {
+            start(metadata, listener, next) {
+              metadata.set(SERVER_TOKEN_HEADER, serverToken);
+              next(metadata, listener);
+            },
+          }),
+      ],
+    };
     
-    this.terminalClient = new blockterm.TerminalService(this.address, credentials);
-    this.suggestionClient = new blockterm.SuggestionService(this.address, credentials);
-    this.historyClient = new blockterm.HistoryService(this.address, credentials);
-    this.systemClient = new blockterm.SystemService(this.address, credentials);
+    this.terminalClient = new blockterm.TerminalService(this.address, credentials, clientOptions);
+    this.suggestionClient = new blockterm.SuggestionService(this.address, credentials, clientOptions);
+    this.historyClient = new blockterm.HistoryService(this.address, credentials, clientOptions);
+    this.systemClient = new blockterm.SystemService(this.address, credentials, clientOptions);
   }
 
   // System methods
@@ -80,6 +105,14 @@ export class GrpcClient extends EventEmitter {
     shell?: string;
     cwd?: string;
     env?: Record<string, string>;
+    markerProtocol?: string;
+    cols?: number;
+    rows?: number;
+    args?: string[];
+    login?: boolean;
+    term?: string;
+    command?: string[];
+    profileId?: string;
   }): Promise<string> {
     return new Promise((resolve, reject) => {
       this.terminalClient.startSession(
@@ -87,11 +120,20 @@ export class GrpcClient extends EventEmitter {
           shell: options.shell || '',
           cwd: options.cwd || '',
           env: options.env || {},
+          markerProtocol: options.markerProtocol || '',
+          cols: options.cols || 0,
+          rows: options.rows || 0,
+          args: options.args || [],
+          login: options.login || false,
+          term: options.term || '',
+          command: options.command || [],
+          profileId: options.profileId || '',
         },
         (err: Error | null, response: any) => {
           if (err) {
             reject(err);
           } else {
+            this.ownerTokens.set(response.sessionId, response.ownerToken);
             resolve(response.sessionId);
           }
         }
@@ -101,10 +143,11 @@ export class GrpcClient extends EventEmitter {
 
   async closeSession(sessionId: string): Promise<boolean> {
     return new Promise((resolve, reject) => {
-      this.terminalClient.closeSession({ sessionId }, (err: Error | null, response: any) => {
+      this.terminalClient.closeSession({ sessionId, shareToken: this.token(sessionId) }, (err: Error | null, response: any) => {
         if (err) {
           reject(err);
         } else {
+          this.ownerTokens.delete(sessionId);
           resolve(response.ok);
         }
       });
@@ -114,7 +157,7 @@ export class GrpcClient extends EventEmitter {
   async resizeSession(sessionId: string, cols: number, rows: number): Promise<boolean> {
     return new Promise((resolve, reject) => {
       this.terminalClient.resizeSession(
-        { sessionId, cols, rows },
+        { sessionId, cols, rows, shareToken: this.token(sessionId) },
         (err: Error | null, response: any) => {
           if (err) {
             reject(err);
@@ -126,6 +169,78 @@ export class GrpcClient extends EventEmitter {
     });
   }
 
+  // Sessions keep running in the backend when the client goes away; these
+  // let a restarted client rediscover them and re-attach via subscribeOutput.
+  // Every other request needs the session's owner token, which this client
+  // only holds for the sessions it started.
+  async listSessions(): Promise<SessionInfo[]> {
+    return new Promise((resolve, reject) => {
+      this.terminalClient.listSessions({}, (err: Error | null, response: any) => {
+        if (err) {
+          reject(err);
+        } else {
+          // After a restart this is how the app gets its sessions back.
+          for (const info of response.sessions || []) {
+            if (info.ownerToken) this.ownerTokens.set(info.sessionId, info.ownerToken);
+          }
+          resolve((response.sessions || []).map(toSessionInfo));
+        }
+      });
+    });
+  }
+
+  async getSession(sessionId: string): Promise<SessionInfo> {
+    return new Promise((resolve, reject) => {
+      this.terminalClient.getSession({ sessionId, shareToken: this.token(sessionId) }, (err: Error | null, response: any) => {
+        if (err) {
+          reject(err);
+        } else {
+          resolve(toSessionInfo(response));
+        }
+      });
+    });
+  }
+
+  async detachSession(sessionId: string): Promise<boolean> {
+    return new Promise((resolve, reject) => {
+      this.terminalClient.detachSession({ sessionId, shareToken: this.token(sessionId) }, (err: Error | null, response: any) => {
+        if (err) {
+          reject(err);
+        } else {
+          resolve(response.ok);
+        }
+      });
+    });
+  }
+
+  async getCwdHistory(sessionId: string): Promise<Array<{ path: string; timestamp: number }>> {
+    return new Promise((resolve, reject) => {
+      this.terminalClient.getCwdHistory({ sessionId, shareToken: this.token(sessionId) }, (err: Error | null, response: any) => {
+        if (err) {
+          reject(err);
+        } else {
+          resolve((response.entries || []).map((entry: any) => ({
+            path: entry.path,
+            timestamp: Number(entry.timestamp),
+          })));
+        }
+      });
+    });
+  }
+
+  // Signal the session's foreground job, e.g. 'INT' for a block's stop button.
+  async signalSession(sessionId: string, signal: string): Promise<{ pgid: number; pids: number[] }> {
+    return new Promise((resolve, reject) => {
+      this.terminalClient.signalSession({ sessionId, signal, shareToken: this.token(sessionId) }, (err: Error | null, response: any) => {
+        if (err) {
+          reject(err);
+        } else {
+          resolve({ pgid: response.pgid, pids: response.pids || [] });
+        }
+      });
+    });
+  }
+
   // Start bidirectional input stream
   createInputStream(): grpc.ClientWritableStream<any> {
     const stream = this.terminalClient.sendInput((err: Error | null, _response: any) => {
@@ -147,27 +262,64 @@ export class GrpcClient extends EventEmitter {
       }
     });
     
-    stream.write({ sessionId, data: inputData });
+    stream.write({ sessionId, data: inputData, shareToken: this.token(sessionId) });
     stream.end();
   }
 
-  // Subscribe to output stream for a session
+  // Subscribe to output stream for a session. The backend first replays its
+  // retained scrollback after the chunk numbered `afterSeq` (0 = everything
+  // it still holds).
   subscribeOutput(
     sessionId: string,
-    onData: (data: Uint8Array) => void,
+    onData: (data: Uint8Array, meta: OutputMeta) => void,
     onError?: (err: Error) => void,
-    onEnd?: () => void
+    onEnd?: () => void,
+    afterSeq: number = 0
   ): () => void {
     // Cancel existing stream for this session if any
     this.unsubscribeOutput(sessionId);
 
-    const stream = this.terminalClient.receiveOutput({ sessionId });
+    const stream = this.terminalClient.receiveOutput({ sessionId, afterSeq, shareToken: this.token(sessionId) });
     this.outputStreams.set(sessionId, stream);
 
     stream.on('data', (chunk: any) => {
-      if (chunk.data) {
-        onData(chunk.data);
-      }
+      // Empty-data chunks still matter: they mark block start/completion.
+      onData(chunk.data ?? new Uint8Array(), {
+        commandId: chunk.commandId ?? '',
+        command: chunk.command ?? '',
+        status: chunk.status ?? '',
+        exitCode: chunk.exitCode ?? 0,
+        failedBy: chunk.failedBy ?? '',
+        offset: Number(chunk.offset ?? 0),
+        seq: Number(chunk.seq ?? 0),
+        state: chunk.state
+          ? { foreground: toProcessInfo(chunk.state.foreground), altScreen: !!chunk.state.altScreen }
+          : undefined,
+        dropped: chunk.dropped ?? 0,
+        exit: chunk.exit
+          ? {
+              exitCode: chunk.exit.exitCode ?? 0,
+              signal: chunk.exit.signal ?? '',
+              closedByUser: !!chunk.exit.closedByUser,
+              reaped: chunk.exit.reaped ?? '',
+            }
+          : undefined,
+        limitWarning: chunk.event
+          ? { reason: chunk.event.reason, deadline: Number(chunk.event.deadline ?? 0) }
+          : undefined,
+        trigger: chunk.trigger
+          ? {
+              triggerId: chunk.trigger.triggerId ?? '',
+              name: chunk.trigger.name ?? '',
+              action: chunk.trigger.action,
+              argument: chunk.trigger.argument ?? '',
+              offset: Number(chunk.trigger.offset ?? 0),
+              length: chunk.trigger.length ?? 0,
+              text: chunk.trigger.text ?? '',
+              commandId: chunk.trigger.commandId ?? '',
+            }
+          : undefined,
+      });
     });
 
     stream.on('error', (err: Error) => {
@@ -185,6 +337,12 @@ export class GrpcClient extends EventEmitter {
     return () => this.unsubscribeOutput(sessionId);
   }
 
+  // The owner token of a session this client started; the backend rejects
+  // requests without it.
+  private token(sessionId: string): string {
+    return this.ownerTokens.get(sessionId) ?? '';
+  }
+
   unsubscribeOutput(sessionId: string): void {
     const stream = this.outputStreams.get(sessionId);
     if (stream) {
@@ -239,7 +397,7 @@ export class GrpcClient extends EventEmitter {
     sessionId: string;
     command: string;
     cwd: string;
-    exitCode: number;
+    exitCode: number | null;
     timestamp: number;
   }>> {
     return new Promise((resolve, reject) => {
@@ -251,7 +409,7 @@ export class GrpcClient extends EventEmitter {
             sessionId: entry.sessionId,
             command: entry.command,
             cwd: entry.cwd,
-            exitCode: entry.exitCode,
+            exitCode: entry.exitCode ?? null,
             timestamp: Number(entry.timestamp),
           }));
           resolve(entries);
@@ -266,6 +424,7 @@ export class GrpcClient extends EventEmitter {
     for (const [sessionId] of this.outputStreams) {
       this.unsubscribeOutput(sessionId);
     }
+    this.ownerTokens.clear();
     
     // Close all clients
     grpc.closeClient(this.terminalClient);
@@ -275,6 +434,33 @@ export class GrpcClient extends EventEmitter {
   }
 }
 
+function toSessionInfo(info: any): SessionInfo {
+  return {
+    sessionId: info.sessionId,
+    shell: info.shell,
+    cwd: info.cwd,
+    cols: info.cols,
+    rows: info.rows,
+    state: info.state,
+    createdAt: Number(info.createdAt),
+    currentCommandId: info.currentCommandId,
+    commandStatus: info.commandStatus,
+    commandExitCode: info.commandExitCode,
+    attachedClients: info.attachedClients,
+    detachedAt: Number(info.detachedAt),
+    foreground: toProcessInfo(info.foreground),
+    altScreen: !!info.altScreen,
+  };
+}
+
+function toProcessInfo(info: any): ProcessInfo {
+  return {
+    pid: info?.pid ?? 0,
+    name: info?.name ?? '',
+    argv: info?.argv ?? [],
+  };
+}
+
 // Singleton instance
 let client: GrpcClient | null = null;
 
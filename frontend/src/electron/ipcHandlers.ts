@@ -5,7 +5,7 @@
 import { ipcMain, BrowserWindow, app } from 'electron';
 import { getGrpcClient } from './grpcClient.js';
 import { getBackendManager } from './backendManager.js';
-import type { CreateSessionOptions, SuggestionMode, EnvInfo, GitInfo } from '../shared/types.js';
+import type { CreateSessionOptions, SuggestionMode, EnvInfo, GitInfo, OutputMeta } from '../shared/types.js';
 import fs from 'node:fs';
 import path from 'node:path';
 import { execFile } from 'node:child_process';
@@ -28,13 +28,17 @@ export function setupIpcHandlers(): void {
       shell: options.shell,
       cwd: options.cwd,
       env: options.env,
+      markerProtocol: options.markerProtocol,
+      // Sized at creation so the first byte is laid out correctly.
+      cols: options.cols,
+      rows: options.rows,
+      args: options.args,
+      login: options.login,
+      term: options.term,
+      command: options.command,
+      profileId: options.profileId,
     });
 
-    // Auto-resize after creation if cols/rows provided
-    if (options.cols && options.rows) {
-      await client.resizeSession(sessionId, options.cols, options.rows);
-    }
-
     return sessionId;
   });
 
@@ -89,13 +93,13 @@ export function setupIpcHandlers(): void {
       // Start the gRPC output stream for this session
       client.subscribeOutput(
         sessionId,
-        (data: Uint8Array) => {
+        (data: Uint8Array, meta: OutputMeta) => {
           // Broadcast to all subscribed windows
           const subs = activeOutputSubscriptions.get(sessionId);
           if (subs) {
             for (const w of subs) {
               if (!w.isDestroyed()) {
-                w.webContents.send('terminal:outputData', sessionId, data);
+                w.webContents.send('terminal:outputData', sessionId, data, meta);
               }
             }
           }
@@ -144,7 +148,7 @@ export function setupIpcHandlers(): void {
     }
     
     const entries = await client.queryHistory(filter || '', limit);
-    return entries.map((entry: { sessionId: string; command: string; cwd: string; exitCode: number; timestamp: number }) => ({
+    return entries.map((entry: { sessionId: string; command: string; cwd: string; exitCode: number | null; timestamp: number }) => ({
       id: `${entry.sessionId}-${entry.timestamp}`,
       ...entry,
     }));
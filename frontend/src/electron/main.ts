@@ -72,6 +72,13 @@ async function startBackend(): Promise<void> {
       } catch (err) {
         console.warn('Backend ping failed:', err);
       }
+      // Sessions outlive the app: recover the owner tokens of the ones
+      // still running so they can be attached to again.
+      try {
+        await client.listSessions();
+      } catch (err) {
+        console.warn('Failed to list sessions:', err);
+      }
     }
   } catch (err) {
     console.error('Failed to start backend:', err);
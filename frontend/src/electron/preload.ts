@@ -13,17 +13,18 @@ import type {
   BackendStatus,
   SavedWorkspace,
   EnvInfo,
+  OutputMeta,
 } from '../shared/types.js';
 
 // Map to track output listeners per session
-const outputListeners = new Map<string, Set<(data: Uint8Array) => void>>();
+const outputListeners = new Map<string, Set<(data: Uint8Array, meta: OutputMeta) => void>>();
 const backendStatusListeners = new Set<(status: BackendStatus, error?: string) => void>();
 
 // Set up IPC listener for output data (once, at preload time)
-ipcRenderer.on('terminal:outputData', (_event, sessionId: string, data: Uint8Array) => {
+ipcRenderer.on('terminal:outputData', (_event, sessionId: string, data: Uint8Array, meta: OutputMeta) => {
   const listeners = outputListeners.get(sessionId);
   if (listeners) {
-    listeners.forEach(cb => cb(data));
+    listeners.forEach(cb => cb(data, meta));
   }
 });
 
@@ -41,7 +42,7 @@ const terminalApi: TerminalApi = {
     ipcRenderer.send('terminal:sendInput', sessionId, data);
   },
 
-  onOutput(sessionId: string, callback: (data: Uint8Array) => void) {
+  onOutput(sessionId: string, callback: (data: Uint8Array, meta: OutputMeta) => void) {
     // Get or create listener set for this session
     let listeners = outputListeners.get(sessionId);
     if (!listeners) {
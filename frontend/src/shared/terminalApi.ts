@@ -9,6 +9,7 @@ import type {
   Suggestion,
   SuggestionMode,
   UnsubscribeFn,
+  OutputMeta,
   BackendStatus,
   SavedWorkspace,
   EnvInfo,
@@ -26,9 +27,10 @@ export interface TerminalApi {
   sendInput(sessionId: string, data: string): void;
 
   /**
-   * Subscribe to output data from a session
+   * Subscribe to output data from a session. Each chunk carries the block
+   * metadata the backend derived from the shell-integration markers.
    */
-  onOutput(sessionId: string, callback: (data: Uint8Array) => void): UnsubscribeFn;
+  onOutput(sessionId: string, callback: (data: Uint8Array, meta: OutputMeta) => void): UnsubscribeFn;
 
   /**
    * Resize a terminal session
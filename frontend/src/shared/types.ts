@@ -13,12 +13,52 @@ export interface Session {
   state: 'active' | 'closed';
 }
 
+// Backend snapshot of a live session (see TerminalService.ListSessions).
+export interface SessionInfo {
+  sessionId: string;
+  shell: string;
+  cwd: string;
+  cols: number;
+  rows: number;
+  state: 'running' | 'closed' | 'exited';
+  createdAt: number;        // unix seconds
+  currentCommandId: string;
+  commandStatus: '' | 'running' | 'completed' | 'failed';
+  commandExitCode: number;
+  attachedClients: number;
+  detachedAt: number;       // unix seconds, 0 while attached
+  foreground: ProcessInfo;  // what owns the terminal (the shell at a prompt)
+  altScreen: boolean;       // a full-screen program is active
+}
+
+export interface ProcessInfo {
+  pid: number;
+  name: string;
+  argv: string[];
+}
+
+// Reported on data-less output chunks when the terminal's owner changes.
+export interface SessionStateChange {
+  foreground: ProcessInfo;
+  altScreen: boolean;
+}
+
 export interface CreateSessionOptions {
   shell?: string;
   cwd?: string;
   cols: number;
   rows: number;
   env?: Record<string, string>;
+  // Shell-integration markers: 'blockterm' (default) or 'osc133'.
+  markerProtocol?: 'blockterm' | 'osc133';
+  args?: string[];     // extra shell arguments
+  login?: boolean;     // start a login shell
+  term?: string;       // TERM override (default xterm-256color)
+  // Run this program (argv) directly instead of a shell, without shell
+  // integration, e.g. ['tail', '-f', 'app.log']. Excludes shell/args/login.
+  command?: string[];
+  // Start from a profile stored in the backend; fields set here override it.
+  profileId?: string;
 }
 
 // History types
@@ -63,6 +103,50 @@ export interface BlockEvent {
   timestamp: number;
 }
 
+// Block metadata attached to every output chunk by the backend, which
+// strips the shell-integration markers and tracks command boundaries.
+export interface OutputMeta {
+  commandId: string;   // '' when the chunk is outside a command block
+  command: string;     // command line, only on the chunk that starts a block
+  status: '' | 'running' | 'completed' | 'failed';
+  exitCode: number;    // only meaningful when status is completed/failed
+  failedBy: string;    // fail_block trigger that failed a block whose command exited 0, else ''
+  offset: number;      // byte offset of the chunk within the session's output
+  seq: number;         // position of the chunk in the session's output; resume after it
+  state?: SessionStateChange; // set when the foreground process or screen changed
+  dropped: number;     // chunks skipped before this one because the client fell behind
+  exit?: SessionExit;  // set on the final chunk once the session's shell has ended
+  limitWarning?: SessionLimitWarning; // set when a session limit is about to close the session
+  trigger?: TriggerHit; // set when a backend trigger matched earlier output
+}
+
+// A backend output trigger matched; the backend has already taken the
+// action, except 'highlight' and 'bell', which are left to the client.
+export interface TriggerHit {
+  triggerId: string;
+  name: string;
+  action: 'event' | 'highlight' | 'send_input' | 'fail_block' | 'bell';
+  argument: string;      // e.g. the highlight style
+  offset: number;        // output offset of the matched text
+  length: number;
+  text: string;
+  commandId: string;
+}
+
+// Warning that the backend will close the session at `deadline`.
+export interface SessionLimitWarning {
+  reason: 'idle' | 'lifetime';
+  deadline: number;      // unix ms
+}
+
+// How a session's shell ended.
+export interface SessionExit {
+  exitCode: number;      // 128+n when killed by signal n
+  signal: string;        // e.g. 'SIGKILL'; '' if the shell exited by itself
+  closedByUser: boolean;
+  reaped: '' | 'idle' | 'lifetime'; // set if a session limit closed it
+}
+
 // Output chunk with optional metadata
 export interface OutputData {
   sessionId: string;
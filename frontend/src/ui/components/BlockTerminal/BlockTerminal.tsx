@@ -36,7 +36,7 @@ export const BlockTerminal: React.FC<BlockTerminalProps> = ({
   autoScroll = true,
   showInput = true,
 }) => {
-  const { blockData, isFullscreen, currentCwd, isCommandRunning, addBlock } = useBlocks({ sessionId, paneId, currentPath });
+  const { blockData, isFullscreen, currentCwd, isCommandRunning, sessionExit, limitWarning, addBlock } = useBlocks({ sessionId, paneId, currentPath });
 
   const containerRef = useRef<HTMLDivElement>(null);
   const xtermRef = useRef<TerminalRef>(null);
@@ -377,7 +377,27 @@ export const BlockTerminal: React.FC<BlockTerminalProps> = ({
         )}
       </div>
 
-      {showInput && !isFullscreen && (
+      {limitWarning && !sessionExit && (
+        <div className="block-terminal-exit">
+          {`Session will be closed at ${new Date(limitWarning.deadline).toLocaleTimeString()} (${
+            limitWarning.reason === 'idle' ? 'idle timeout' : 'maximum lifetime'
+          })`}
+        </div>
+      )}
+
+      {sessionExit && (
+        <div className="block-terminal-exit">
+          {sessionExit.reaped
+            ? `Session closed (${sessionExit.reaped === 'idle' ? 'idle timeout' : 'maximum lifetime'})`
+            : sessionExit.closedByUser
+              ? 'Session closed'
+              : sessionExit.signal
+                ? `Shell killed by ${sessionExit.signal}`
+                : `Shell exited with ${sessionExit.exitCode}`}
+        </div>
+      )}
+
+      {showInput && !isFullscreen && !sessionExit && (
         <BlockInput
           currentPath={currentCwd || currentPath}
           sessionId={sessionId}
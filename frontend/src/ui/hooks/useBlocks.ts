@@ -1,18 +1,19 @@
 /**
  * Hook for managing command blocks from a terminal session.
  *
- * Subscribes to raw PTY output and parses the BlockTerm shell-integration
- * markers to automatically detect block boundaries and trim output:
+ * The backend parses the BlockTerm shell-integration markers, strips them
+ * from the stream and tags every output chunk with block metadata:
  *
- *   <<<BLOCKTERM:START>>>   – emitted by the shell's preexec hook
- *   <<<BLOCKTERM:END exit=N>>>  – emitted by the shell's precmd hook
+ *   status 'running'              – chunk belongs to the command block
+ *   status 'completed' / 'failed' – the block finished with `exitCode`
+ *   status ''                     – prompt text outside any block
  *
- * Everything outside these markers (prompt text, escape sequences, etc.) is
- * discarded. Each block's `output` contains only the text between them.
+ * Everything outside a block (prompt text, escape sequences, etc.) is
+ * discarded. Each block's `output` contains only the command's own output.
  */
 
 import { useState, useEffect, useCallback, useRef, useMemo } from 'react';
-import type { Block, PythonEnvInfo } from '../../shared/types';
+import type { Block, OutputMeta, PythonEnvInfo, SessionExit, SessionLimitWarning } from '../../shared/types';
 import { cleanTerminalOutput, findTrailingPartialEscape } from '../lib/utils';
 import {
   registerPaneBlocks,
@@ -20,11 +21,6 @@ import {
   consumeRestoredPaneData,
 } from '../services/workspaceStore';
 
-// ── Marker constants ──────────────────────────────────────────────────────────
-const MARKER_START = '<<<BLOCKTERM:START>>>';
-const MARKER_END_PREFIX = '<<<BLOCKTERM:END exit='; // used for partial-match detection
-const MARKER_END_RE = /<<<BLOCKTERM:END exit=(-?\d+)>>>/;
-
 // Matches OSC 7 sequences used by shells to broadcast cwd changes:
 //   \x1b]7;file://hostname/path\x07   (BEL-terminated)
 //   \x1b]7;file://hostname/path\x1b\  (ST-terminated)
@@ -80,23 +76,6 @@ function extractPyenvMarker(text: string): PythonEnvInfo | null | undefined {
   return null; // marker present, all values empty → no active env
 }
 
-// ── Helpers ───────────────────────────────────────────────────────────────────
-
-/**
- * Checks whether `text` (from index `from`) ends with a *partial* prefix of
- * `needle`. Returns the index where that partial fragment starts, or -1.
- * Used to save the tail of a chunk when a marker might be split across chunks.
- */
-function findTrailingFragment(text: string, from: number, needle: string): number {
-  const tail = text.slice(from);
-  for (let len = Math.min(needle.length - 1, tail.length); len > 0; len--) {
-    if (tail.endsWith(needle.slice(0, len))) {
-      return from + tail.length - len;
-    }
-  }
-  return -1;
-}
-
 // ── Types ─────────────────────────────────────────────────────────────────────
 
 export interface BlockData {
@@ -130,14 +109,16 @@ export function useBlocks({ sessionId, paneId, currentPath = '~' }: UseBlocksOpt
 
   // ── Parser state (per-session, reset on sessionId change) ──────────────────
   const activeBlockIdRef = useRef<string | null>(null); // block currently receiving output
-  const activeBlockCommandRef = useRef<string>('');     // command text of the active block
-  const inBlockRef = useRef(false);                     // between START and END?
-  const fragmentRef = useRef('');                       // partial marker from previous chunk
+  const activeCommandIdRef = useRef<string | null>(null); // backend command ID of the active block
   const rawBufferRef = useRef('');                      // partial ANSI escape from previous chunk
   // True while we are between a START and END marker (command is executing).
   // Exposed so callers can route user input directly to the PTY instead of
   // creating a new block (e.g. when a command asks for credentials).
   const [isCommandRunning, setIsCommandRunning] = useState(false);
+  // Set once the backend reports that the session's shell has ended.
+  const [sessionExit, setSessionExit] = useState<SessionExit | null>(null);
+  // Latest warning that a session limit will close the session.
+  const [limitWarning, setLimitWarning] = useState<SessionLimitWarning | null>(null);
 
   // ── Workspace restore tracking ────────────────────────────────────────────
   /** True after restored blocks have been injected (prevents re-reset). */
@@ -157,12 +138,12 @@ export function useBlocks({ sessionId, paneId, currentPath = '~' }: UseBlocksOpt
     }
 
     activeBlockIdRef.current = null;
-    activeBlockCommandRef.current = '';
-    inBlockRef.current = false;
-    fragmentRef.current = '';
+    activeCommandIdRef.current = null;
     rawBufferRef.current = '';
     setIsFullscreen(false);
     setIsCommandRunning(false);
+    setSessionExit(null);
+    setLimitWarning(null);
 
     // Check for restored workspace data (only once per pane lifetime)
     if (paneId && !hasRestoredRef.current) {
@@ -206,7 +187,7 @@ export function useBlocks({ sessionId, paneId, currentPath = '~' }: UseBlocksOpt
   useEffect(() => {
     if (!sessionId) return;
 
-    const unsubscribe = window.terminalApi.onOutput(sessionId, (chunk: Uint8Array) => {
+    const unsubscribe = window.terminalApi.onOutput(sessionId, (chunk: Uint8Array, meta: OutputMeta) => {
       // Reassemble any partial ANSI escape left over from the previous chunk
       // so that cleanTerminalOutput can always process complete sequences.
       const rawFull = rawBufferRef.current + new TextDecoder().decode(chunk);
@@ -221,14 +202,26 @@ export function useBlocks({ sessionId, paneId, currentPath = '~' }: UseBlocksOpt
         raw = rawFull;
       }
 
-      // Detect alternate-screen transitions before any cleaning.
-      // \x1b[?1049h / \x1b[?47h  → app entered fullscreen (vim, nano, htop, …)
-      // \x1b[?1049l / \x1b[?47l  → app left fullscreen
-      if (raw.includes('\x1b[?1049h') || raw.includes('\x1b[?47h')) {
-        setIsFullscreen(true);
+      // The backend reports alternate-screen transitions (vim, nano, htop, …)
+      // as state changes, so sequences split across chunks are not missed.
+      if (meta.state) {
+        setIsFullscreen(meta.state.altScreen);
       }
-      if (raw.includes('\x1b[?1049l') || raw.includes('\x1b[?47l')) {
+
+      // The final chunk of the stream says how the shell ended.
+      if (meta.limitWarning) {
+        setLimitWarning(meta.limitWarning);
+      }
+      // Triggers configured to notify show up even when the pane is not
+      // focused.
+      if (meta.trigger?.action === 'event') {
+        new Notification(meta.trigger.name || 'Trigger matched', { body: meta.trigger.text });
+      }
+      if (meta.exit) {
+        setSessionExit(meta.exit);
+        setLimitWarning(null);
         setIsFullscreen(false);
+        setIsCommandRunning(false);
       }
 
       // Parse OSC 7 cwd announcements (must happen on raw bytes, before cleaning).
@@ -248,99 +241,55 @@ export function useBlocks({ sessionId, paneId, currentPath = '~' }: UseBlocksOpt
         setPythonEnv(newPyenv);
       }
 
-      // Reassemble any partial marker left over from the previous chunk.
-      let text = fragmentRef.current + cleaned;
-      fragmentRef.current = '';
-
-      let pos = 0;
-
-      while (pos < text.length) {
-        if (!inBlockRef.current) {
-          // ── Looking for START ───────────────────────────────────────────
-          const startIdx = text.indexOf(MARKER_START, pos);
-
-          if (startIdx === -1) {
-            // No START in this text – but the tail might be a partial marker.
-            const fragIdx = findTrailingFragment(text, pos, MARKER_START);
-            if (fragIdx !== -1) {
-              fragmentRef.current = text.slice(fragIdx);
-            }
-            // Discard everything else (prompt text, shell echoes, etc.).
-            break;
-          }
-
-          // Activate the pending block registered by addBlock(), or create an
-          // auto-detected block for commands run via the plain terminal.
-          if (activeBlockIdRef.current === null) {
-            const autoBlock: Block = {
-              id: `block-auto-${Date.now()}`,
-              sessionId: sessionId!,
-              command: '',
-              cwd: cwdRef.current,
-              startOffset: 0,
-              endOffset: null,
-              exitCode: null,
-              timestamp: Date.now(),
-              collapsed: false,
-            };
-            blockContentsRef.current.set(autoBlock.id, '');
-            activeBlockIdRef.current = autoBlock.id;
-            setBlocks(prev => [...prev, autoBlock]);
-          }
-
-          inBlockRef.current = true;
-          setIsCommandRunning(true);
-          pos = startIdx + MARKER_START.length;
-
-        } else {
-          // ── Inside a block: looking for END ────────────────────────────
-          const remaining = text.slice(pos);
-          const endMatch = MARKER_END_RE.exec(remaining);
-
-          if (!endMatch) {
-            // No END yet – save partial END marker for next chunk.
-            const fragIdx = findTrailingFragment(text, pos, MARKER_END_PREFIX);
-            const contentEnd = fragIdx !== -1 ? fragIdx : text.length;
-            if (fragIdx !== -1) {
-              fragmentRef.current = text.slice(fragIdx);
-            }
-            appendToBlock(remaining.slice(0, contentEnd - pos));
-            break;
-          }
-
-          // Found complete END marker.
-          const exitCode = parseInt(endMatch[1], 10);
-          const endMarkerOffset = endMatch.index!;
-
-          // Flush content that precedes the END marker.
-          appendToBlock(remaining.slice(0, endMarkerOffset));
-
-          // Close the block.
-          const closedId = activeBlockIdRef.current!;
-          const closedCommand = activeBlockCommandRef.current;
-          const closedAt = Date.now();
-          setBlocks(prev =>
-            prev.map(b =>
-              b.id === closedId ? { ...b, exitCode, endOffset: b.startOffset } : b,
-            ),
-          );
-
-          // Persist to history (fire-and-forget; non-blocking).
-          if (closedCommand && sessionId) {
-            // Use the cwd snapshotted when the block was created, not currentPath.
-            const closedBlock = blocks.find(b => b.id === closedId);
-            const closedCwd = closedBlock?.cwd ?? cwdRef.current;
-            window.terminalApi
-              .recordCommand(sessionId, closedCommand, closedCwd, exitCode, closedAt)
-              .catch(err => console.warn('history: failed to record command:', err));
-          }
-
-          activeBlockIdRef.current = null;
-          activeBlockCommandRef.current = '';
-          inBlockRef.current = false;
-          setIsCommandRunning(false);
-          pos += endMarkerOffset + endMatch[0].length;
+      // ── Block start ───────────────────────────────────────────────────
+      if (meta.status === 'running' && activeCommandIdRef.current !== meta.commandId) {
+        // Activate the pending block registered by addBlock(), or create an
+        // auto-detected block for commands run via the plain terminal.
+        if (activeBlockIdRef.current === null) {
+          const autoBlock: Block = {
+            id: `block-auto-${Date.now()}`,
+            sessionId: sessionId!,
+            command: meta.command,
+            cwd: cwdRef.current,
+            startOffset: 0,
+            endOffset: null,
+            exitCode: null,
+            timestamp: Date.now(),
+            collapsed: false,
+          };
+          blockContentsRef.current.set(autoBlock.id, '');
+          activeBlockIdRef.current = autoBlock.id;
+          setBlocks(prev => [...prev, autoBlock]);
         }
+
+        activeCommandIdRef.current = meta.commandId;
+        setIsCommandRunning(true);
+      }
+
+      // ── Block output ──────────────────────────────────────────────────
+      // Anything outside a block (prompt text, shell echoes) is discarded.
+      if (meta.status === 'running' && activeCommandIdRef.current === meta.commandId) {
+        appendToBlock(cleaned);
+      }
+
+      // ── Block end ─────────────────────────────────────────────────────
+      if (
+        (meta.status === 'completed' || meta.status === 'failed') &&
+        activeCommandIdRef.current === meta.commandId &&
+        activeBlockIdRef.current !== null
+      ) {
+        // History is recorded by the backend from the shell integration.
+        const exitCode = meta.exitCode;
+        const closedId = activeBlockIdRef.current;
+        setBlocks(prev =>
+          prev.map(b =>
+            b.id === closedId ? { ...b, exitCode, endOffset: b.startOffset } : b,
+          ),
+        );
+
+        activeBlockIdRef.current = null;
+        activeCommandIdRef.current = null;
+        setIsCommandRunning(false);
       }
     });
 
@@ -382,7 +331,6 @@ export function useBlocks({ sessionId, paneId, currentPath = '~' }: UseBlocksOpt
 
       blockContentsRef.current.set(newBlock.id, '');
       activeBlockIdRef.current = newBlock.id;
-      activeBlockCommandRef.current = command;
       setBlocks(prev => [...prev, newBlock]);
     },
     [sessionId],
@@ -421,5 +369,5 @@ export function useBlocks({ sessionId, paneId, currentPath = '~' }: UseBlocksOpt
     };
   }, [paneId]);
 
-  return { blocks, blockData, isFullscreen, currentCwd, pythonEnv, isCommandRunning, addBlock, toggleCollapse };
+  return { blocks, blockData, isFullscreen, currentCwd, pythonEnv, isCommandRunning, sessionExit, limitWarning, addBlock, toggleCollapse };
 }